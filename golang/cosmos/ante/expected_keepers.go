@@ -1,6 +1,7 @@
 package ante
 
 import (
+	sdkmath "cosmossdk.io/math"
 	swingtypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -23,4 +24,7 @@ type FeegrantKeeper interface {
 type SwingsetKeeper interface {
 	InboundQueueLength(ctx sdk.Context) (int32, error)
 	GetState(ctx sdk.Context) swingtypes.State
+	GetInboundAdmissions(ctx sdk.Context, key string) int32
+	AddInboundAdmissions(ctx sdk.Context, key string, count int32)
+	GetInboundPrice(ctx sdk.Context) (sdkmath.Uint, sdk.Coins, bool)
 }
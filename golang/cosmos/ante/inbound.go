@@ -3,6 +3,8 @@ package ante
 import (
 	"github.com/armon/go-metrics"
 
+	sdkioerrors "cosmossdk.io/errors"
+
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -32,6 +34,15 @@ the number of messages allowed for mempool admission as if its max
 queue length was lower (e.g. 50%). This is the QueueInboundMempool
 entry in the Swingset state QueueAllowed field. At DeliverTx time
 the QueueInbound entry gives the number of allowed messages.
+
+
+Beyond the overall inbound queue size, the QueueAllowed field may also give
+per-block admission budgets for each Msg type (QueueInboundMsgTypePrefix
+entries) and for each sender (the QueueInboundSender entry), so that a flood
+of one kind of message or from one account cannot crowd out the others.
+Admissions against these budgets are counted in the Swingset transient store,
+which is reset on commit, both at CheckTx and DeliverTx time.  High priority
+messages are exempt from the budgets but count towards them.
 */
 
 const (
@@ -41,6 +52,22 @@ const (
 // TODO: We don't have a more appropriate error type for this.
 var ErrInboundQueueFull = sdkerrors.ErrMempoolIsFull
 
+var (
+	// ErrInboundMsgTypeLimit is returned when the per-block admission budget of a
+	// Msg type has been used up.
+	ErrInboundMsgTypeLimit = sdkioerrors.Wrap(sdkerrors.ErrMempoolIsFull, "inbound message type admission limit reached")
+	// ErrInboundSenderLimit is returned when the per-block admission budget of a
+	// sender has been used up.
+	ErrInboundSenderLimit = sdkioerrors.Wrap(sdkerrors.ErrMempoolIsFull, "inbound sender admission limit reached")
+)
+
+// Reasons for rejecting an inbound message, as telemetry labels.
+const (
+	inboundRejectQueueFull    = "queue_full"
+	inboundRejectMsgTypeLimit = "msg_type_limit"
+	inboundRejectSenderLimit  = "sender_limit"
+)
+
 // inboundAnte is an sdk.AnteDecorator which enforces the allowed size of the inbound queue.
 type inboundAnte struct {
 	sk SwingsetKeeper
@@ -56,15 +83,23 @@ func NewInboundDecorator(sk SwingsetKeeper) sdk.AnteDecorator {
 // with pure Cosmos-level Txs.
 func (ia inboundAnte) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
 	msgs := tx.GetMsgs()
+	var state *swingtypes.State
 	inboundsAllowed := int32(-1)
+	// admissions holds the budgeted admissions of the Tx by budget key.
+	admissions := map[string]int32{}
+	admissionKeys := []string{}
 	for _, msg := range msgs {
 		inbounds := inboundMessages(msg)
 		if inbounds == 0 {
 			continue
 		}
+		if state == nil {
+			s := ia.sk.GetState(ctx)
+			state = &s
+		}
 		if inboundsAllowed == -1 {
 			var err error
-			inboundsAllowed, err = ia.allowedInbound(ctx)
+			inboundsAllowed, err = ia.allowedInbound(ctx, *state)
 			if err != nil {
 				return ctx, err
 			}
@@ -73,26 +108,77 @@ func (ia inboundAnte) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next
 		if err != nil {
 			return ctx, err
 		}
-		if inboundsAllowed >= inbounds {
-			inboundsAllowed -= inbounds
-		} else if isHighPriority {
-			inboundsAllowed = 0
-		} else {
+
+		reason, budgetKeys := ia.checkBudgets(ctx, *state, msg, inbounds, admissions)
+		if reason == "" || isHighPriority {
+			if inboundsAllowed >= inbounds {
+				inboundsAllowed -= inbounds
+			} else if isHighPriority {
+				inboundsAllowed = 0
+			} else {
+				reason = inboundRejectQueueFull
+			}
+		}
+		if reason != "" && !isHighPriority {
 			defer func() {
 				telemetry.IncrCounterWithLabels(
 					[]string{"tx", "ante", "inbound_not_allowed"},
 					1,
 					[]metrics.Label{
 						telemetry.NewLabel("msg", sdk.MsgTypeURL(msg)),
+						telemetry.NewLabel("reason", reason),
 					},
 				)
 			}()
+			switch reason {
+			case inboundRejectMsgTypeLimit:
+				return ctx, ErrInboundMsgTypeLimit
+			case inboundRejectSenderLimit:
+				return ctx, ErrInboundSenderLimit
+			}
 			return ctx, ErrInboundQueueFull
 		}
+		for _, key := range budgetKeys {
+			if _, found := admissions[key]; !found {
+				admissionKeys = append(admissionKeys, key)
+			}
+			admissions[key] += inbounds
+		}
+	}
+	for _, key := range admissionKeys {
+		ia.sk.AddInboundAdmissions(ctx, key, admissions[key])
 	}
 	return next(ctx, tx, simulate)
 }
 
+// checkBudgets returns the reason for rejecting msg if admitting it would
+// exceed a per-block admission budget given the pending admissions of its Tx,
+// and the keys of the budgets that it counts towards.
+func (ia inboundAnte) checkBudgets(ctx sdk.Context, state swingtypes.State, msg sdk.Msg, inbounds int32, pending map[string]int32) (string, []string) {
+	var reason string
+	var budgetKeys []string
+	exceeds := func(key string, budget int32) bool {
+		budgetKeys = append(budgetKeys, key)
+		return ia.sk.GetInboundAdmissions(ctx, key)+pending[key]+inbounds > budget
+	}
+
+	typeKey := swingtypes.QueueInboundMsgTypePrefix + sdk.MsgTypeURL(msg)
+	if budget, found := swingtypes.QueueSizeEntry(state.QueueAllowed, typeKey); found {
+		if exceeds(typeKey, budget) {
+			reason = inboundRejectMsgTypeLimit
+		}
+	}
+	if budget, found := swingtypes.QueueSizeEntry(state.QueueAllowed, swingtypes.QueueInboundSender); found {
+		if signers := msg.GetSigners(); len(signers) > 0 {
+			senderKey := swingtypes.QueueInboundSender + ":" + signers[0].String()
+			if exceeds(senderKey, budget) && reason == "" {
+				reason = inboundRejectSenderLimit
+			}
+		}
+	}
+	return reason, budgetKeys
+}
+
 func (ia inboundAnte) isPriorityMessage(ctx sdk.Context, msg sdk.Msg) (bool, error) {
 	if c, ok := msg.(vm.ControllerAdmissionMsg); ok {
 		return c.IsHighPriority(ctx, ia.sk)
@@ -103,8 +189,7 @@ func (ia inboundAnte) isPriorityMessage(ctx sdk.Context, msg sdk.Msg) (bool, err
 // allowedInbound returns the allowed number of inbound queue messages or an error.
 // Look up the limit from the swingset state queue sizes: from QueueInboundMempool
 // if we're running CheckTx (for the hysteresis described above), otherwise QueueAllowed.
-func (ia inboundAnte) allowedInbound(ctx sdk.Context) (int32, error) {
-	state := ia.sk.GetState(ctx)
+func (ia inboundAnte) allowedInbound(ctx sdk.Context, state swingtypes.State) (int32, error) {
 	entry := swingtypes.QueueInbound
 	if ctx.IsCheckTx() {
 		entry = swingtypes.QueueInboundMempool
@@ -0,0 +1,59 @@
+package ante
+
+import (
+	sdkioerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// inboundPriceAnte is an sdk.AnteDecorator which enforces the congestion price
+// of inbound messages, if enabled, by rejecting Txs whose fee does not cover
+// that price for each of their inbound messages.  The price is set by
+// x/swingset at the start of each block, so it is the same at CheckTx and
+// DeliverTx time.
+type inboundPriceAnte struct {
+	sk SwingsetKeeper
+}
+
+// NewInboundPriceDecorator returns an AnteDecorator which enforces the price
+// of inbound messages.
+func NewInboundPriceDecorator(sk SwingsetKeeper) sdk.AnteDecorator {
+	return inboundPriceAnte{sk: sk}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+// Simulated Txs are exempt, since they are used to estimate gas before the fee
+// is known.
+func (ipa inboundPriceAnte) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if simulate {
+		return next(ctx, tx, simulate)
+	}
+	inbounds := int64(0)
+	for _, msg := range tx.GetMsgs() {
+		inbounds += int64(inboundMessages(msg))
+	}
+	if inbounds == 0 {
+		return next(ctx, tx, simulate)
+	}
+	_, price, enabled := ipa.sk.GetInboundPrice(ctx)
+	if !enabled {
+		return next(ctx, tx, simulate)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkioerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+	required := sdk.NewCoins()
+	for _, coin := range price {
+		required = required.Add(sdk.NewCoin(coin.Denom, coin.Amount.Mul(sdkmath.NewInt(inbounds))))
+	}
+	if fee := feeTx.GetFee(); !fee.IsAllGTE(required) {
+		telemetry.IncrCounter(1, "tx", "ante", "inbound_price_not_met")
+		return ctx, sdkioerrors.Wrapf(sdkerrors.ErrInsufficientFee,
+			"insufficient fee for %d inbound messages; got: %s required: %s", inbounds, fee, required)
+	}
+	return next(ctx, tx, simulate)
+}
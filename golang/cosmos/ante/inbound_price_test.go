@@ -0,0 +1,87 @@
+package ante
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	swingtypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/gogo/protobuf/proto"
+)
+
+func makeTestTxWithFee(fee sdk.Coins, msgs ...proto.Message) sdk.Tx {
+	t := makeTestTx(msgs...).(*tx.Tx)
+	t.AuthInfo = &tx.AuthInfo{Fee: &tx.Fee{Amount: fee}}
+	return t
+}
+
+func TestInboundPriceAnteHandle(t *testing.T) {
+	price := sdk.NewCoins(sdk.NewInt64Coin("uist", 10_000))
+	for _, tt := range []struct {
+		name     string
+		tx       sdk.Tx
+		simulate bool
+		enabled  bool
+		wantErr  bool
+	}{
+		{
+			name: "disabled",
+			tx:   makeTestTxWithFee(nil, &swingtypes.MsgWalletSpendAction{}),
+		},
+		{
+			name:    "no-inbound-msgs",
+			tx:      makeTestTxWithFee(nil, &banktypes.MsgSend{}),
+			enabled: true,
+		},
+		{
+			name:    "fee-covers-price",
+			tx:      makeTestTxWithFee(sdk.NewCoins(sdk.NewInt64Coin("uist", 10_000)), &swingtypes.MsgWalletSpendAction{}),
+			enabled: true,
+		},
+		{
+			name:    "fee-below-price",
+			tx:      makeTestTxWithFee(sdk.NewCoins(sdk.NewInt64Coin("uist", 9_999)), &swingtypes.MsgWalletSpendAction{}),
+			enabled: true,
+			wantErr: true,
+		},
+		{
+			name:    "fee-in-other-denom",
+			tx:      makeTestTxWithFee(sdk.NewCoins(sdk.NewInt64Coin("ubld", 1_000_000)), &swingtypes.MsgDeliverInbound{}),
+			enabled: true,
+			wantErr: true,
+		},
+		{
+			name:    "price-per-inbound-msg",
+			tx:      makeTestTxWithFee(sdk.NewCoins(sdk.NewInt64Coin("uist", 15_000)), &swingtypes.MsgWalletAction{}, &swingtypes.MsgWalletSpendAction{}),
+			enabled: true,
+			wantErr: true,
+		},
+		{
+			name:     "simulate",
+			tx:       makeTestTxWithFee(nil, &swingtypes.MsgWalletSpendAction{}),
+			simulate: true,
+			enabled:  true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithContext(context.Background())
+			mock := mockSwingsetKeeper{
+				inboundPrice:        price,
+				inboundPriceEnabled: tt.enabled,
+			}
+			decorator := NewInboundPriceDecorator(mock)
+			_, err := decorator.AnteHandle(ctx, tt.tx, tt.simulate, nilAnteHandler)
+			if tt.wantErr {
+				if !errors.Is(err, sdkerrors.ErrInsufficientFee) {
+					t.Errorf("want insufficient fee error, got %v", err)
+				}
+			} else if err != nil {
+				t.Errorf("want no error, got %v", err)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package ante
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
 	"testing"
@@ -27,6 +28,8 @@ func TestInboundAnteHandle(t *testing.T) {
 		mempoolLimit          int32
 		errMsg                string
 		isHighPriorityOwner   bool
+		budgets               []swingtypes.QueueSize
+		admissions            map[string]int32
 	}{
 		{
 			name: "empty-empty",
@@ -126,6 +129,71 @@ func TestInboundAnteHandle(t *testing.T) {
 			isHighPriorityOwner: true,
 			inboundLimit:        1,
 		},
+		{
+			name:         "msg-type-has-budget",
+			tx:           makeTestTx(&swingtypes.MsgWalletSpendAction{}),
+			inboundLimit: 10,
+			budgets: []swingtypes.QueueSize{
+				swingtypes.NewQueueSize(swingtypes.QueueInboundMsgTypePrefix+"/agoric.swingset.MsgWalletSpendAction", 3),
+			},
+			admissions: map[string]int32{
+				swingtypes.QueueInboundMsgTypePrefix + "/agoric.swingset.MsgWalletSpendAction": 2,
+			},
+		},
+		{
+			name:         "msg-type-budget-used",
+			tx:           makeTestTx(&swingtypes.MsgDeliverInbound{}),
+			inboundLimit: 10,
+			budgets: []swingtypes.QueueSize{
+				swingtypes.NewQueueSize(swingtypes.QueueInboundMsgTypePrefix+"/agoric.swingset.MsgDeliverInbound", 3),
+			},
+			admissions: map[string]int32{
+				swingtypes.QueueInboundMsgTypePrefix + "/agoric.swingset.MsgDeliverInbound": 3,
+			},
+			errMsg: ErrInboundMsgTypeLimit.Error(),
+		},
+		{
+			name:         "other-msg-type-budget-used",
+			tx:           makeTestTx(&swingtypes.MsgWalletSpendAction{}),
+			inboundLimit: 10,
+			budgets: []swingtypes.QueueSize{
+				swingtypes.NewQueueSize(swingtypes.QueueInboundMsgTypePrefix+"/agoric.swingset.MsgDeliverInbound", 3),
+			},
+			admissions: map[string]int32{
+				swingtypes.QueueInboundMsgTypePrefix + "/agoric.swingset.MsgDeliverInbound": 3,
+			},
+		},
+		{
+			name:         "sender-budget-used",
+			tx:           makeTestTx(&swingtypes.MsgWalletAction{Owner: sdk.AccAddress("owner")}),
+			inboundLimit: 10,
+			budgets: []swingtypes.QueueSize{
+				swingtypes.NewQueueSize(swingtypes.QueueInboundSender, 1),
+			},
+			admissions: map[string]int32{
+				swingtypes.QueueInboundSender + ":" + sdk.AccAddress("owner").String(): 1,
+			},
+			errMsg: ErrInboundSenderLimit.Error(),
+		},
+		{
+			name:         "other-sender-has-budget",
+			tx:           makeTestTx(&swingtypes.MsgWalletAction{Owner: sdk.AccAddress("other")}),
+			inboundLimit: 10,
+			budgets: []swingtypes.QueueSize{
+				swingtypes.NewQueueSize(swingtypes.QueueInboundSender, 1),
+			},
+			admissions: map[string]int32{
+				swingtypes.QueueInboundSender + ":" + sdk.AccAddress("owner").String(): 1,
+			},
+		},
+		{
+			name:                "priority-budget-bypass",
+			tx:                  makeTestTx(&swingtypes.MsgWalletSpendAction{}),
+			isHighPriorityOwner: true,
+			budgets: []swingtypes.QueueSize{
+				swingtypes.NewQueueSize(swingtypes.QueueInboundMsgTypePrefix+"/agoric.swingset.MsgWalletSpendAction", 0),
+			},
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			ctx := sdk.Context{}.WithContext(context.Background()).WithIsCheckTx(tt.checkTx)
@@ -140,6 +208,11 @@ func TestInboundAnteHandle(t *testing.T) {
 				mempoolLimit:          tt.mempoolLimit,
 				emptyQueueAllowed:     emptyQueueAllowed,
 				isHighPriorityOwner:   tt.isHighPriorityOwner,
+				budgets:               tt.budgets,
+				admissions:            map[string]int32{},
+			}
+			for key, count := range tt.admissions {
+				mock.admissions[key] = count
 			}
 			decorator := NewInboundDecorator(mock)
 			newCtx, err := decorator.AnteHandle(ctx, tt.tx, tt.simulate, nilAnteHandler)
@@ -159,6 +232,34 @@ func TestInboundAnteHandle(t *testing.T) {
 	}
 }
 
+func TestInboundAnteCountsAdmissions(t *testing.T) {
+	ctx := sdk.Context{}.WithContext(context.Background())
+	typeKey := swingtypes.QueueInboundMsgTypePrefix + "/agoric.swingset.MsgDeliverInbound"
+	senderKey := swingtypes.QueueInboundSender + ":" + sdk.AccAddress("submitter").String()
+	mock := mockSwingsetKeeper{
+		inboundLimit: 10,
+		budgets: []swingtypes.QueueSize{
+			swingtypes.NewQueueSize(typeKey, 2),
+			swingtypes.NewQueueSize(swingtypes.QueueInboundSender, 5),
+		},
+		admissions: map[string]int32{},
+	}
+	decorator := NewInboundDecorator(mock)
+	tx := makeTestTx(&swingtypes.MsgDeliverInbound{Submitter: sdk.AccAddress("submitter")})
+	for i := 0; i < 2; i++ {
+		if _, err := decorator.AnteHandle(ctx, tx, false, nilAnteHandler); err != nil {
+			t.Fatalf("admission %d: %v", i, err)
+		}
+	}
+	if _, err := decorator.AnteHandle(ctx, tx, false, nilAnteHandler); !errors.Is(err, ErrInboundMsgTypeLimit) {
+		t.Errorf("want error %v, got %v", ErrInboundMsgTypeLimit, err)
+	}
+	want := map[string]int32{typeKey: 2, senderKey: 2}
+	if !reflect.DeepEqual(mock.admissions, want) {
+		t.Errorf("want admissions %v, got %v", want, mock.admissions)
+	}
+}
+
 func makeTestTx(msgs ...proto.Message) sdk.Tx {
 	wrappedMsgs := make([]*types.Any, len(msgs))
 	for i, m := range msgs {
@@ -186,6 +287,10 @@ type mockSwingsetKeeper struct {
 	mempoolLimit          int32
 	emptyQueueAllowed     bool
 	isHighPriorityOwner   bool
+	budgets               []swingtypes.QueueSize
+	admissions            map[string]int32
+	inboundPrice          sdk.Coins
+	inboundPriceEnabled   bool
 }
 
 var _ SwingsetKeeper = mockSwingsetKeeper{}
@@ -200,13 +305,25 @@ func (msk mockSwingsetKeeper) GetState(ctx sdk.Context) swingtypes.State {
 		return swingtypes.State{}
 	}
 	return swingtypes.State{
-		QueueAllowed: []swingtypes.QueueSize{
+		QueueAllowed: append([]swingtypes.QueueSize{
 			swingtypes.NewQueueSize(swingtypes.QueueInbound, msk.inboundLimit),
 			swingtypes.NewQueueSize(swingtypes.QueueInboundMempool, msk.mempoolLimit),
-		},
+		}, msk.budgets...),
 	}
 }
 
+func (msk mockSwingsetKeeper) GetInboundAdmissions(ctx sdk.Context, key string) int32 {
+	return msk.admissions[key]
+}
+
+func (msk mockSwingsetKeeper) AddInboundAdmissions(ctx sdk.Context, key string, count int32) {
+	msk.admissions[key] += count
+}
+
+func (msk mockSwingsetKeeper) GetInboundPrice(ctx sdk.Context) (sdkmath.Uint, sdk.Coins, bool) {
+	return sdkmath.ZeroUint(), msk.inboundPrice, msk.inboundPriceEnabled
+}
+
 func (msk mockSwingsetKeeper) IsHighPriorityAddress(ctx sdk.Context, addr sdk.AccAddress) (bool, error) {
 	return msk.isHighPriorityOwner, nil
 }
@@ -215,7 +332,7 @@ func (msk mockSwingsetKeeper) GetBeansPerUnit(ctx sdk.Context) map[string]sdkmat
 	return nil
 }
 
-func (msk mockSwingsetKeeper) ChargeBeans(ctx sdk.Context, addr sdk.AccAddress, beans sdkmath.Uint) error {
+func (msk mockSwingsetKeeper) ChargeBeans(ctx sdk.Context, addr sdk.AccAddress, beans sdkmath.Uint, chargeType string) error {
 	return fmt.Errorf("not implemented")
 }
 
@@ -226,3 +343,7 @@ func (msk mockSwingsetKeeper) GetSmartWalletState(ctx sdk.Context, addr sdk.AccA
 func (msk mockSwingsetKeeper) ChargeForSmartWallet(ctx sdk.Context, addr sdk.AccAddress) error {
 	return fmt.Errorf("not implemented")
 }
+
+func (msk mockSwingsetKeeper) GetBundleUpload(ctx sdk.Context, id uint64) (swingtypes.BundleUpload, bool) {
+	return swingtypes.BundleUpload{}, false
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/server/api"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
@@ -145,6 +146,35 @@ const appName = "agoric"
 // TODO: document this flag in config, likely alongside the genesis path
 const FlagSwingStoreExportDir = "swing-store-export-dir"
 
+// FlagSwingStoreArtifactMode defines the config flag used to select the set of
+// artifacts restored into the JS swing-store when starting from a genesis
+// with a swing-store export. The default is "operational"; "replay",
+// "archival" and "debug" require the export to contain the corresponding
+// artifacts.
+const FlagSwingStoreArtifactMode = "swing-store-artifact-mode"
+
+// FlagSwingStoreExportParentDir defines the config flag used to make a genesis
+// swing-store export incremental from the swing-store export in that
+// directory, only exporting the artifacts missing from it.
+const FlagSwingStoreExportParentDir = "swing-store-export-parent-dir"
+
+// FlagSwingStoreSnapshotFormat defines the config flag used to specify the
+// format of the swingset extension payloads of new state-sync snapshots: 1
+// (the default for this release, readable by nodes of earlier releases) or 2
+// (chunked artifacts, which only nodes of this release and later can restore).
+const FlagSwingStoreSnapshotFormat = "swing-store-snapshot-format"
+
+// FlagVstorageStreamCellHistoryPaths defines the config flag used to opt in to
+// retaining a node-local history of the StreamCells written at the listed
+// vstorage paths and their descendants, for the StreamCellHistory query.
+// The history is kept in a "vstorage-history" database in the data directory.
+const FlagVstorageStreamCellHistoryPaths = "vstorage-stream-cell-history-paths"
+
+// FlagVstorageWatchRetainBlocks defines the config flag used to specify how
+// many recently committed blocks of vstorage changes are retained in memory
+// for resuming Watch queries, or zero to disable Watch queries.
+const FlagVstorageWatchRetainBlocks = "vstorage-watch-retain-blocks"
+
 var (
 	// DefaultNodeHome default home directories for the application daemon
 	DefaultNodeHome string
@@ -201,6 +231,7 @@ var (
 		vbanktypes.ReservePoolName:     nil,
 		vbanktypes.ProvisionPoolName:   nil,
 		vbanktypes.GiveawayPoolName:    nil,
+		swingset.ModuleName:            nil,
 	}
 )
 
@@ -333,10 +364,10 @@ func NewAgoricApp(
 		govtypes.StoreKey, paramstypes.StoreKey, ibchost.StoreKey, upgradetypes.StoreKey,
 		evidencetypes.StoreKey, ibctransfertypes.StoreKey, packetforwardtypes.StoreKey,
 		capabilitytypes.StoreKey, feegrant.StoreKey, authzkeeper.StoreKey, icahosttypes.StoreKey,
-		swingset.StoreKey, vstorage.StoreKey, vibc.StoreKey,
+		swingset.StoreKey, vstorage.StoreKey, vstorage.MetaStoreKey, vibc.StoreKey,
 		vlocalchain.StoreKey, vtransfer.StoreKey, vbank.StoreKey,
 	)
-	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
+	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey, swingset.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 
 	app := &GaiaApp{
@@ -469,13 +500,27 @@ func NewAgoricApp(
 	}
 
 	app.VstorageKeeper = vstorage.NewKeeper(
-		keys[vstorage.StoreKey],
+		keys[vstorage.StoreKey], keys[vstorage.MetaStoreKey], app.GetSubspace(vstorage.ModuleName),
 	)
+	if historyPaths := cast.ToStringSlice(appOpts.Get(FlagVstorageStreamCellHistoryPaths)); len(historyPaths) > 0 {
+		historyDB, err := dbm.NewDB("vstorage-history", server.GetAppDBBackend(appOpts), filepath.Join(homePath, "data"))
+		if err != nil {
+			panic(err)
+		}
+		app.VstorageKeeper.SetStreamCellHistory(vstorage.NewStreamCellHistory(historyDB, historyPaths))
+	}
+	watchRetainBlocks := int64(vstorage.DefaultWatchRetainBlocks)
+	if opt := appOpts.Get(FlagVstorageWatchRetainBlocks); opt != nil {
+		watchRetainBlocks = cast.ToInt64(opt)
+	}
+	if watchRetainBlocks > 0 {
+		app.VstorageKeeper.SetChangeFeed(vstorage.NewChangeFeed(watchRetainBlocks))
+	}
 	app.vstoragePort = app.AgdServer.MustRegisterPortHandler("vstorage", vstorage.NewStorageHandler(app.VstorageKeeper))
 
 	// The SwingSetKeeper is the Keeper from the SwingSet module
 	app.SwingSetKeeper = swingset.NewKeeper(
-		appCodec, keys[swingset.StoreKey], app.GetSubspace(swingset.ModuleName),
+		appCodec, keys[swingset.StoreKey], tkeys[swingset.TStoreKey], app.GetSubspace(swingset.ModuleName),
 		app.AccountKeeper, app.BankKeeper,
 		app.VstorageKeeper, vbanktypes.ReservePoolName,
 		callToController,
@@ -511,6 +556,11 @@ func NewAgoricApp(
 		&app.SwingStoreExportsHandler,
 		getSwingStoreExportDataShadowCopyReader,
 	)
+	if opt := appOpts.Get(FlagSwingStoreSnapshotFormat); opt != nil {
+		if err := app.SwingSetSnapshotter.SetSnapshotFormat(cast.ToUint32(opt)); err != nil {
+			panic(err)
+		}
+	}
 
 	app.VibcKeeper = vibc.NewKeeper(
 		appCodec,
@@ -661,6 +711,8 @@ func NewAgoricApp(
 	app.EvidenceKeeper = *evidenceKeeper
 
 	swingStoreExportDir := cast.ToString(appOpts.Get(FlagSwingStoreExportDir))
+	swingStoreArtifactMode := cast.ToString(appOpts.Get(FlagSwingStoreArtifactMode))
+	swingStoreExportParentDir := cast.ToString(appOpts.Get(FlagSwingStoreExportParentDir))
 
 	// NOTE: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.
@@ -690,7 +742,7 @@ func NewAgoricApp(
 		icaModule,
 		packetforward.NewAppModule(app.PacketForwardKeeper),
 		vstorage.NewAppModule(app.VstorageKeeper),
-		swingset.NewAppModule(app.SwingSetKeeper, &app.SwingStoreExportsHandler, setBootstrapNeeded, app.ensureControllerInited, swingStoreExportDir),
+		swingset.NewAppModule(app.SwingSetKeeper, &app.SwingStoreExportsHandler, setBootstrapNeeded, app.ensureControllerInited, swingStoreExportDir, swingStoreArtifactMode, swingStoreExportParentDir),
 		vibcModule,
 		vbankModule,
 		vtransferModule,
@@ -879,6 +931,7 @@ func NewAgoricApp(
 				packetforwardtypes.ModuleName, // Added PFM
 				vlocalchain.ModuleName,        // Agoric added vlocalchain
 				vtransfer.ModuleName,          // Agoric added vtransfer
+				vstorage.MetaStoreKey,         // Agoric added vstorage bookkeeping
 			},
 			Deleted: []string{
 				"lien", // Agoric removed the lien module
@@ -951,6 +1004,17 @@ type cosmosInitAction struct {
 // Name returns the name of the App
 func (app *GaiaApp) Name() string { return app.BaseApp.Name() }
 
+// Close closes the vstorage StreamCell history database, if any, along with
+// the BaseApp.
+func (app *GaiaApp) Close() error {
+	if history := app.VstorageKeeper.GetStreamCellHistory(); history != nil {
+		if err := history.Close(); err != nil {
+			return err
+		}
+	}
+	return app.BaseApp.Close()
+}
+
 // CheckControllerInited exits if the controller initialization state does not match `expected`.
 func (app *GaiaApp) CheckControllerInited(expected bool) {
 	if app.controllerInited != expected {
@@ -1077,6 +1141,10 @@ func (app *GaiaApp) Commit() abci.ResponseCommit {
 
 	res, snapshotHeight := app.BaseApp.CommitWithoutSnapshot()
 
+	if feed := app.VstorageKeeper.GetChangeFeed(); feed != nil {
+		feed.Commit(app.LastBlockHeight())
+	}
+
 	err = swingset.AfterCommitBlock(app.SwingSetKeeper)
 	if err != nil {
 		panic(err.Error())
@@ -1251,6 +1319,7 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(icahosttypes.SubModuleName)
 	paramsKeeper.Subspace(swingset.ModuleName)
 	paramsKeeper.Subspace(vbank.ModuleName)
+	paramsKeeper.Subspace(vstorage.ModuleName)
 
 	return paramsKeeper
 }
@@ -175,6 +175,9 @@ func unreleasedUpgradeHandler(app *GaiaApp, targetUpgrade string) func(sdk.Conte
 
 				// upgrade the provisioning vat
 				vm.CoreProposalStepForModules("@agoric/builders/scripts/vats/replace-provisioning.js"),
+				// upgrade the walletFactory so that smart wallets accept the
+				// WALLET_ACTION_BATCH of MsgWalletActionBatch
+				vm.CoreProposalStepForModules("@agoric/builders/scripts/smart-wallet/build-wallet-factory2-upgrade.js"),
 				// Enable low-level Orchestration.
 				vm.CoreProposalStepForModules(
 					"@agoric/builders/scripts/vats/init-network.js",
@@ -204,6 +207,8 @@ func unreleasedUpgradeHandler(app *GaiaApp, targetUpgrade string) func(sdk.Conte
 			return mvm, err
 		}
 
+		// Add the swingset params new in this upgrade (inbound_pricing) with
+		// their default values.
 		m := swingsetkeeper.NewMigrator(app.SwingSetKeeper)
 		err = m.MigrateParams(ctx)
 		if err != nil {
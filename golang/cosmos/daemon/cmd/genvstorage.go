@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage"
+	vstoragetypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/types"
+)
+
+// AddGenesisVstorageCmd returns add-genesis-vstorage cobra Command.
+func AddGenesisVstorageCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-genesis-vstorage <jsonl_file>",
+		Short: "Add vstorage data from a JSON Lines file to genesis.json",
+		Long: `Add vstorage data from a JSON Lines file to genesis.json.
+Each line of the file must be a [path, value] JSON array setting the data at a
+vstorage path, or a [path] JSON array removing it, as produced by
+"query vstorage export". Use "-" to read from standard input.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			config.SetRoot(clientCtx.HomeDir)
+
+			genFile := config.GenesisFile()
+			appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %s", err)
+			}
+
+			var vstorageGenState vstoragetypes.GenesisState
+			if appState[vstorage.ModuleName] != nil {
+				clientCtx.Codec.MustUnmarshalJSON(appState[vstorage.ModuleName], &vstorageGenState)
+			}
+
+			// Closing the reader closes the input file, but never stdin.
+			var input io.ReadCloser = io.NopCloser(os.Stdin)
+			if args[0] != "-" {
+				file, err := os.Open(args[0])
+				if err != nil {
+					return err
+				}
+				input = file
+			}
+			reader := agoric.NewJsonlKVEntryDecoderReader(input)
+			defer reader.Close()
+
+			if err := vstorage.MergeGenesisEntries(&vstorageGenState, reader); err != nil {
+				return fmt.Errorf("failed to read vstorage entries: %s", err)
+			}
+			if err := vstorage.ValidateGenesis(&vstorageGenState); err != nil {
+				return err
+			}
+
+			vstorageGenStateBz, err := clientCtx.Codec.MarshalJSON(&vstorageGenState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal vstorage genesis state: %s", err)
+			}
+
+			appState[vstorage.ModuleName] = vstorageGenStateBz
+
+			appStateJSON, err := json.Marshal(appState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal application genesis state: %s", err)
+			}
+
+			genDoc.AppState = appStateJSON
+			return genutil.ExportGenesisFile(genDoc, genFile)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
@@ -0,0 +1,239 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
+	tmcfg "github.com/tendermint/tendermint/config"
+	tmos "github.com/tendermint/tendermint/libs/os"
+
+	gaia "github.com/Agoric/agoric-sdk/golang/cosmos/app"
+	swingsetkeeper "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/keeper"
+)
+
+// FlagArtifactMode is the command-line flag for the "import" command selecting
+// the set of swing-store artifacts to restore.
+const FlagArtifactMode = "artifact-mode"
+
+// ImportCmd returns the "import" command, which initializes a node home to
+// start from the genesis export in an export-dir created by "export".
+func ImportCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import [moniker]",
+		Short: "Initialize a node home from a genesis export directory, to be restored by the next start",
+		Long: `Initialize a node home from an export directory created by "export".
+The swing-store export is first verified against the genesis.json of the
+export directory.  Then the selected artifact mode is recorded in app.toml
+(created if missing), the private validator and p2p configuration files are
+created if missing, and the genesis.json and swing-store export are copied
+into the node home.  The swing-store export is not restored by this command,
+but by the next "start", using the recorded artifact mode.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+			config.SetRoot(clientCtx.HomeDir)
+
+			exportDir, err := cmd.Flags().GetString(FlagExportDir)
+			if err != nil {
+				return err
+			}
+			artifactMode, err := cmd.Flags().GetString(FlagArtifactMode)
+			if err != nil {
+				return err
+			}
+			overwrite, err := cmd.Flags().GetBool(genutilcli.FlagOverwrite)
+			if err != nil {
+				return err
+			}
+
+			err = swingsetkeeper.ValidateSwingStoreRestoreOptions(swingsetkeeper.SwingStoreRestoreOptions{
+				ArtifactMode:   artifactMode,
+				ExportDataMode: swingsetkeeper.SwingStoreExportDataModeAll,
+			})
+			if err != nil {
+				return err
+			}
+
+			if tmos.FileExists(filepath.Join(config.DBDir(), "application.db")) {
+				return fmt.Errorf("node home %s already has application data", config.RootDir)
+			}
+			genFile := config.GenesisFile()
+			if !overwrite && tmos.FileExists(genFile) {
+				return fmt.Errorf("genesis.json file already exists: %v", genFile)
+			}
+			swingStoreExportDir := serverCtx.Viper.GetString(gaia.FlagSwingStoreExportDir)
+			if swingStoreExportDir == "" {
+				swingStoreExportDir = filepath.Join(config.RootDir, "config", ExportedSwingStoreDirectoryName)
+			}
+			if !overwrite && tmos.FileExists(swingStoreExportDir) {
+				return fmt.Errorf("swing-store export directory already exists: %v", swingStoreExportDir)
+			}
+
+			exportedGenesisPath := filepath.Join(exportDir, ExportedGenesisFileName)
+			exportedSwingStorePath := filepath.Join(exportDir, ExportedSwingStoreDirectoryName)
+			options, err := swingStoreExportVerifyOptionsFromGenesis(exportedGenesisPath)
+			if err != nil {
+				return err
+			}
+			report, err := swingsetkeeper.VerifySwingStoreExportDirectory(exportedSwingStorePath, options)
+			if err != nil {
+				return err
+			}
+			for _, problem := range report.Problems {
+				cmd.PrintErrf("PROBLEM: %s\n", problem)
+			}
+			if !report.OK() {
+				return fmt.Errorf("swing-store export has %d problems", len(report.Problems))
+			}
+			if report.Incremental {
+				return fmt.Errorf("swing-store export is incremental, use \"export merge\" first")
+			}
+
+			// Record the artifact mode before writing anything else, so that a
+			// node home without an app.toml is not left half-imported.
+			appConfigPath := filepath.Join(config.RootDir, "config", "app.toml")
+			err = ensureAppConfigFile(appConfigPath)
+			if err != nil {
+				return err
+			}
+			err = setAppConfigValue(appConfigPath, gaia.FlagSwingStoreArtifactMode, artifactMode)
+			if err != nil {
+				return err
+			}
+
+			nodeID, _, err := genutil.InitializeNodeValidatorFiles(config)
+			if err != nil {
+				return err
+			}
+			if len(args) > 0 {
+				config.Moniker = args[0]
+			}
+			tmcfg.WriteConfigFile(filepath.Join(config.RootDir, "config", "config.toml"), config)
+
+			err = copyFile(exportedGenesisPath, genFile)
+			if err != nil {
+				return err
+			}
+			err = os.RemoveAll(swingStoreExportDir)
+			if err != nil {
+				return err
+			}
+			err = copyDirectory(exportedSwingStorePath, swingStoreExportDir)
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("Imported genesis at height %d into %s for node %s (%s)\n",
+				report.BlockHeight, config.RootDir, config.Moniker, nodeID)
+			cmd.Printf("Swing-store export will be restored with artifact mode %q on start\n", artifactMode)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(FlagExportDir, "", "The directory containing the genesis export")
+	cmd.Flags().String(FlagArtifactMode, swingsetkeeper.SwingStoreArtifactModeOperational,
+		"The set of swing-store artifacts to restore (operational, replay, archival or debug)")
+	cmd.Flags().Bool(genutilcli.FlagOverwrite, false, "Overwrite an existing genesis.json and swing-store export")
+	err := cmd.MarkFlagRequired(FlagExportDir)
+	if err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+// copyFile copies the file at src to dst, replacing any existing file.
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(out, in)
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// copyDirectory creates dst with a copy of the files of the flat directory src.
+// Files are hard-linked when possible since swing-store exports can be large.
+func copyDirectory(src, dst string) error {
+	entries, err := os.ReadDir(src)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(dst, os.ModePerm)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			return fmt.Errorf("unexpected non-file %s in %s", entry.Name(), src)
+		}
+		srcPath := filepath.Join(src, entry.Name())
+		dstPath := filepath.Join(dst, entry.Name())
+		if os.Link(srcPath, dstPath) == nil {
+			continue
+		}
+		err = copyFile(srcPath, dstPath)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ensureAppConfigFile writes the default app.toml file at path if it is
+// missing, as "init" would.
+func ensureAppConfigFile(path string) error {
+	if tmos.FileExists(path) {
+		return nil
+	}
+	err := os.MkdirAll(filepath.Dir(path), os.ModePerm)
+	if err != nil {
+		return err
+	}
+	customAppTemplate, customAppConfig := initAppConfig()
+	serverconfig.SetConfigTemplate(customAppTemplate)
+	serverconfig.WriteConfigFile(path, customAppConfig)
+	return nil
+}
+
+// setAppConfigValue sets a top-level string value in the app.toml file at
+// path, replacing any previous setting.
+func setAppConfigValue(path, key, value string) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	setting := fmt.Sprintf("%s = %s", key, strconv.Quote(value))
+	existing := regexp.MustCompile(`(?m)^` + regexp.QuoteMeta(key) + `\s*=.*$`)
+	if existing.Match(content) {
+		content = existing.ReplaceAllLiteral(content, []byte(setting))
+	} else {
+		// Top-level keys must precede any table.
+		content = append([]byte(setting+"\n\n"), content...)
+	}
+	return os.WriteFile(path, content, 0o644)
+}
@@ -0,0 +1,117 @@
+package cmd_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	svrcmd "github.com/cosmos/cosmos-sdk/server/cmd"
+
+	app "github.com/Agoric/agoric-sdk/golang/cosmos/app"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/daemon/cmd"
+	swingsetkeeper "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/keeper"
+)
+
+func writeTestExportDir(t *testing.T) string {
+	exportDir := t.TempDir()
+	swingStoreDir := filepath.Join(exportDir, cmd.ExportedSwingStoreDirectoryName)
+	require.NoError(t, os.Mkdir(swingStoreDir, 0o755))
+	files := map[string]string{
+		swingsetkeeper.ExportManifestFilename: `{"blockHeight":42,"data":"export-data.jsonl","artifacts":[["bundle.b1-abc","0-bundle.b1-abc"]]}`,
+		"export-data.jsonl":                   `["bundle.b1-abc","b1-abc"]` + "\n",
+		"0-bundle.b1-abc":                     "zip",
+	}
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(swingStoreDir, name), []byte(content), 0o644))
+	}
+
+	report, err := swingsetkeeper.VerifySwingStoreExportDirectory(swingStoreDir, swingsetkeeper.SwingStoreExportVerifyOptions{})
+	require.NoError(t, err)
+	require.True(t, report.OK(), report.Problems)
+
+	genesis := fmt.Sprintf(`{
+  "chain_id": "agoric-import-test",
+  "initial_height": "43",
+  "app_state": {"swingset": {"swing_store_export_data_hash": %q}}
+}`, report.ExportDataHash)
+	require.NoError(t, os.WriteFile(filepath.Join(exportDir, cmd.ExportedGenesisFileName), []byte(genesis), 0o644))
+	return exportDir
+}
+
+func runImport(homeDir string, args ...string) error {
+	rootCmd, _ := cmd.NewRootCmd(nil)
+	rootCmd.SetArgs(append([]string{"import", "--home", homeDir}, args...))
+	return svrcmd.Execute(rootCmd, "", app.DefaultNodeHome)
+}
+
+func TestImportCmd(t *testing.T) {
+	exportDir := writeTestExportDir(t)
+	homeDir := t.TempDir()
+
+	require.NoError(t, runImport(homeDir, "importer", "--export-dir", exportDir, "--artifact-mode", "replay"))
+
+	for _, file := range []string{
+		"config/genesis.json",
+		"config/node_key.json",
+		"config/priv_validator_key.json",
+		"config/swing-store/" + swingsetkeeper.ExportManifestFilename,
+		"config/swing-store/0-bundle.b1-abc",
+	} {
+		require.FileExists(t, filepath.Join(homeDir, file))
+	}
+	appConfig, err := os.ReadFile(filepath.Join(homeDir, "config", "app.toml"))
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(string(appConfig), app.FlagSwingStoreArtifactMode+` = "replay"`))
+	tmConfig, err := os.ReadFile(filepath.Join(homeDir, "config", "config.toml"))
+	require.NoError(t, err)
+	require.Contains(t, string(tmConfig), `moniker = "importer"`)
+
+	// A second import needs --overwrite, and replaces the artifact mode.
+	require.ErrorContains(t, runImport(homeDir, "--export-dir", exportDir), "already exists")
+	require.NoError(t, runImport(homeDir, "--export-dir", exportDir, "--overwrite"))
+	appConfig, err = os.ReadFile(filepath.Join(homeDir, "config", "app.toml"))
+	require.NoError(t, err)
+	require.Equal(t, 1, strings.Count(string(appConfig), app.FlagSwingStoreArtifactMode))
+	require.Contains(t, string(appConfig), app.FlagSwingStoreArtifactMode+` = "operational"`)
+}
+
+func TestImportCmdRejectsBadExport(t *testing.T) {
+	exportDir := writeTestExportDir(t)
+	homeDir := t.TempDir()
+
+	require.ErrorContains(t, runImport(homeDir, "--export-dir", exportDir, "--artifact-mode", "none"), "invalid swing-store artifact mode")
+
+	require.NoError(t, os.Remove(filepath.Join(exportDir, cmd.ExportedSwingStoreDirectoryName, "0-bundle.b1-abc")))
+	require.ErrorContains(t, runImport(homeDir, "--export-dir", exportDir), "swing-store export has 1 problems")
+	require.NoFileExists(t, filepath.Join(homeDir, "config", "genesis.json"))
+}
+
+func TestImportCmdCreatesAppConfig(t *testing.T) {
+	exportDir := writeTestExportDir(t)
+	homeDir := t.TempDir()
+	appConfigPath := filepath.Join(homeDir, "config", "app.toml")
+
+	// Remove the app.toml written by the root command's config interception
+	// as soon as it is written, as if the node home had none.
+	rootCmd, _ := cmd.NewRootCmd(nil)
+	rootCmd.SetArgs([]string{"import", "--home", homeDir, "--export-dir", exportDir})
+	preRunE := rootCmd.PersistentPreRunE
+	rootCmd.PersistentPreRunE = func(c *cobra.Command, args []string) error {
+		if err := preRunE(c, args); err != nil {
+			return err
+		}
+		return os.Remove(appConfigPath)
+	}
+	require.NoError(t, svrcmd.Execute(rootCmd, "", app.DefaultNodeHome))
+
+	appConfig, err := os.ReadFile(appConfigPath)
+	require.NoError(t, err)
+	require.Contains(t, string(appConfig), app.FlagSwingStoreArtifactMode+` = "operational"`)
+	require.Contains(t, string(appConfig), `minimum-gas-prices = "0uist"`)
+	require.FileExists(t, filepath.Join(homeDir, "config", "genesis.json"))
+}
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -31,12 +32,15 @@ import (
 	tmcfg "github.com/tendermint/tendermint/config"
 	tmcli "github.com/tendermint/tendermint/libs/cli"
 	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
 	gaia "github.com/Agoric/agoric-sdk/golang/cosmos/app"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/app/params"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
 	swingsetkeeper "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/keeper"
+	swingsettypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage"
 )
 
 var AppName = "agd"
@@ -136,6 +140,8 @@ func initRootCmd(sender vm.Sender, rootCmd *cobra.Command, encodingConfig params
 		genutilcli.GenTxCmd(gaia.ModuleBasics, encodingConfig.TxConfig, banktypes.GenesisBalancesIterator{}, gaia.DefaultNodeHome),
 		genutilcli.ValidateGenesisCmd(gaia.ModuleBasics),
 		AddGenesisAccountCmd(encodingConfig.Marshaler, gaia.DefaultNodeHome),
+		AddGenesisVstorageCmd(gaia.DefaultNodeHome),
+		ImportCmd(gaia.DefaultNodeHome),
 		tmcli.NewCompletionCmd(rootCmd, true),
 		testnetCmd(gaia.ModuleBasics, banktypes.GenesisBalancesIterator{}),
 		debug.Cmd(),
@@ -151,6 +157,7 @@ func initRootCmd(sender vm.Sender, rootCmd *cobra.Command, encodingConfig params
 		case "export":
 			addAgoricVMFlags(command)
 			extendCosmosExportCommand(command)
+			command.AddCommand(verifyExportCommand(), mergeExportCommand())
 		case "snapshots":
 			for _, subCommand := range command.Commands() {
 				switch subCommand.Name() {
@@ -199,6 +206,26 @@ func addAgoricVMFlags(cmd *cobra.Command) {
 
 func addModuleInitFlags(startCmd *cobra.Command) {
 	addAgoricVMFlags(startCmd)
+	startCmd.Flags().StringSlice(
+		gaia.FlagVstorageStreamCellHistoryPaths,
+		nil,
+		"Retain the history of StreamCells written at these vstorage paths and their descendants",
+	)
+	startCmd.Flags().Int64(
+		gaia.FlagVstorageWatchRetainBlocks,
+		vstorage.DefaultWatchRetainBlocks,
+		"Retain vstorage changes of this many recent blocks for resuming watches (0 disables watching)",
+	)
+	startCmd.Flags().Uint32(
+		gaia.FlagSwingStoreSnapshotFormat,
+		swingsetkeeper.DefaultSnapshotFormat,
+		"The format of the swingset payloads of new state-sync snapshots (1, or 2 once all state-syncing nodes can restore it)",
+	)
+	startCmd.Flags().String(
+		gaia.FlagSwingStoreArtifactMode,
+		swingsetkeeper.SwingStoreArtifactModeOperational,
+		"The set of artifacts to restore when starting from a genesis with a swing-store export (operational, replay, archival or debug)",
+	)
 }
 
 func queryCommand() *cobra.Command {
@@ -338,6 +365,10 @@ const (
 	// ExportedSwingStoreDirectoryName is the directory name used to save the swing-store
 	// export (artifacts only) in the export-dir
 	ExportedSwingStoreDirectoryName = "swing-store"
+	// FlagIncrementalFrom is the command-line flag for the "export" command
+	// specifying a previous export-dir from which the swing-store export is
+	// incremental
+	FlagIncrementalFrom = "incremental-from"
 )
 
 // extendCosmosExportCommand monkey-patches the "export" command added by
@@ -345,6 +376,7 @@ const (
 // genesis export in the specified directory if the VM is running.
 func extendCosmosExportCommand(cmd *cobra.Command) {
 	cmd.Flags().String(FlagExportDir, "", "The directory where to create the genesis export")
+	cmd.Flags().String(FlagIncrementalFrom, "", "A previous export directory from which to only export new swing-store artifacts")
 	err := cmd.MarkFlagRequired(FlagExportDir)
 	if err != nil {
 		panic(err)
@@ -374,6 +406,18 @@ func extendCosmosExportCommand(cmd *cobra.Command) {
 		// current genesis.
 		serverCtx.Viper.Set(gaia.FlagSwingStoreExportDir, swingStoreExportPath)
 
+		parentExportDir, _ := cmd.Flags().GetString(FlagIncrementalFrom)
+		swingStoreExportParentPath := ""
+		if parentExportDir != "" {
+			// The JS swing-store export runs in a separate process, which reads
+			// the parent export manifest.
+			swingStoreExportParentPath, err = filepath.Abs(filepath.Join(parentExportDir, ExportedSwingStoreDirectoryName))
+			if err != nil {
+				return err
+			}
+		}
+		serverCtx.Viper.Set(gaia.FlagSwingStoreExportParentDir, swingStoreExportParentPath)
+
 		if hasVMController(serverCtx) {
 			// Capture the export in the genesisPath.
 			// This will fail if a genesis.json already exists in the export-dir
@@ -398,6 +442,167 @@ func extendCosmosExportCommand(cmd *cobra.Command) {
 	cmd.RunE = extendedRunE
 }
 
+// verifyExportCommand returns the "export verify" command, which checks the
+// swing-store export of an export-dir on disk without running the VM.
+func verifyExportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify",
+		Short: "Verify the swing-store export of an export directory",
+		Long: `Verify the swing-store export of an export directory created by "export".
+Checks the export manifest, the export data, and that every artifact is
+present and matches the export data.  The block height and export data hash
+are checked against the genesis.json of the export directory, if any.
+Bundle contents can only be verified by importing the export.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			exportDir, err := cmd.Flags().GetString(FlagExportDir)
+			if err != nil {
+				return err
+			}
+			height, err := cmd.Flags().GetUint64(flags.FlagHeight)
+			if err != nil {
+				return err
+			}
+
+			var options swingsetkeeper.SwingStoreExportVerifyOptions
+			genesisPath := filepath.Join(exportDir, ExportedGenesisFileName)
+			if _, err := os.Stat(genesisPath); err == nil {
+				options, err = swingStoreExportVerifyOptionsFromGenesis(genesisPath)
+				if err != nil {
+					return err
+				}
+			}
+			if height != 0 {
+				options.BlockHeight = height
+			}
+
+			swingStoreExportPath := filepath.Join(exportDir, ExportedSwingStoreDirectoryName)
+			report, err := swingsetkeeper.VerifySwingStoreExportDirectory(swingStoreExportPath, options)
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("Swing-store export at height %d: %d export data entries (%s), %d artifacts\n",
+				report.BlockHeight, report.ExportDataEntries, report.ExportDataHash, report.Artifacts)
+			cmd.Printf("Verified %d artifacts, %d left to verify on import\n",
+				report.VerifiedArtifacts, len(report.UnverifiedArtifacts))
+			if report.Incremental {
+				cmd.Printf("Incremental export omitting %d artifacts of its parent exports\n", report.ParentArtifacts)
+			}
+			for _, problem := range report.Problems {
+				cmd.PrintErrf("PROBLEM: %s\n", problem)
+			}
+			if !report.OK() {
+				return fmt.Errorf("swing-store export has %d problems", len(report.Problems))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagExportDir, "", "The directory containing the genesis export")
+	cmd.Flags().Uint64(flags.FlagHeight, 0, "The expected block height of the export (default from genesis.json)")
+	err := cmd.MarkFlagRequired(FlagExportDir)
+	if err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+// mergeExportCommand returns the "export merge" command, which merges a chain
+// of incremental exports into a restorable export-dir.
+func mergeExportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "merge <export-dir>...",
+		Short: "Merge a chain of incremental export directories into a full export",
+		Long: `Merge a chain of export directories created by "export" into a full export.
+The export directories are listed by increasing height, starting with a full
+export, each following export being created with --incremental-from the
+previous one.  The merged export directory has the genesis.json and swing-store
+export data of the last export, and all the swing-store artifacts it needs.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			exportDir, err := cmd.Flags().GetString(FlagExportDir)
+			if err != nil {
+				return err
+			}
+
+			// Check the whole chain of exports before writing anything.
+			swingStoreExportPaths := make([]string, len(args))
+			for i, arg := range args {
+				swingStoreExportPaths[i] = filepath.Join(arg, ExportedSwingStoreDirectoryName)
+			}
+			provider, err := swingsetkeeper.OpenMergedSwingStoreExportDirectories(swingStoreExportPaths)
+			if err != nil {
+				return err
+			}
+			lastGenesis, err := os.ReadFile(filepath.Join(args[len(args)-1], ExportedGenesisFileName))
+			if err != nil {
+				return err
+			}
+
+			swingStoreExportPath := filepath.Join(exportDir, ExportedSwingStoreDirectoryName)
+			err = os.MkdirAll(swingStoreExportPath, os.ModePerm)
+			if err != nil {
+				return err
+			}
+
+			// This will fail if a genesis.json already exists in the export-dir
+			genesisFile, err := os.OpenFile(
+				filepath.Join(exportDir, ExportedGenesisFileName),
+				os.O_CREATE|os.O_EXCL|os.O_WRONLY,
+				os.ModePerm,
+			)
+			if err != nil {
+				return err
+			}
+			defer genesisFile.Close()
+			_, err = genesisFile.Write(lastGenesis)
+			if err != nil {
+				return err
+			}
+
+			return swingsetkeeper.WriteSwingStoreExportToDirectory(provider, swingStoreExportPath)
+		},
+	}
+
+	cmd.Flags().String(FlagExportDir, "", "The directory where to create the merged genesis export")
+	err := cmd.MarkFlagRequired(FlagExportDir)
+	if err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+// swingStoreExportVerifyOptionsFromGenesis returns the expected block height and export
+// data hash of the swing-store export accompanying an exported genesis.
+func swingStoreExportVerifyOptionsFromGenesis(genesisPath string) (swingsetkeeper.SwingStoreExportVerifyOptions, error) {
+	var options swingsetkeeper.SwingStoreExportVerifyOptions
+	genDoc, err := tmtypes.GenesisDocFromFile(genesisPath)
+	if err != nil {
+		return options, err
+	}
+	if genDoc.InitialHeight > 1 {
+		options.BlockHeight = uint64(genDoc.InitialHeight - 1)
+	}
+
+	var appState map[string]json.RawMessage
+	err = json.Unmarshal(genDoc.AppState, &appState)
+	if err != nil {
+		return options, err
+	}
+	var swingsetGenesis struct {
+		SwingStoreExportDataHash string `json:"swing_store_export_data_hash"`
+	}
+	if rawSwingsetGenesis, ok := appState[swingsettypes.ModuleName]; ok {
+		err = json.Unmarshal(rawSwingsetGenesis, &swingsetGenesis)
+		if err != nil {
+			return options, err
+		}
+	}
+	options.ExportDataHash = swingsetGenesis.SwingStoreExportDataHash
+	return options, nil
+}
+
 func (ac appCreator) appExport(
 	logger log.Logger,
 	db dbm.DB,
@@ -46,8 +46,23 @@ func BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, keeper Keeper) erro
 	}
 
 	err = keeper.UpdateQueueAllowed(ctx)
+	if err != nil {
+		return err
+	}
 
-	return err
+	err = keeper.UpdateInboundPrice(ctx)
+	if err != nil {
+		return err
+	}
+
+	keeper.PruneChargeHistory(ctx)
+
+	err = keeper.PruneExpiredBundleUploads(ctx)
+	if err != nil {
+		return err
+	}
+
+	return keeper.DeliverScheduledActions(ctx)
 }
 
 var endBlockHeight int64
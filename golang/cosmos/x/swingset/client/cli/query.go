@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"strconv"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
@@ -21,6 +23,17 @@ func GetQueryCmd(storeKey string) *cobra.Command {
 		GetCmdGetEgress(storeKey),
 		GetCmdQueryParams(storeKey),
 		GetCmdMailbox(storeKey),
+		GetCmdEgresses(storeKey),
+		GetCmdMailboxes(storeKey),
+		GetCmdBeansOwing(storeKey),
+		GetCmdChargeHistory(storeKey),
+		GetCmdInboundQueue(storeKey),
+		GetCmdInboundPrice(storeKey),
+		GetCmdScheduledAction(storeKey),
+		GetCmdScheduledActions(storeKey),
+		GetCmdBundle(storeKey),
+		GetCmdBundles(storeKey),
+		GetCmdBundleUpload(storeKey),
 	)
 
 	return swingsetQueryCmd
@@ -115,3 +128,393 @@ func GetCmdMailbox(queryRoute string) *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+const flagPowerFlag = "power-flag"
+
+// GetCmdEgresses lists the provisioned egresses
+func GetCmdEgresses(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "egresses",
+		Short: "list provisioned egresses",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			powerFlag, err := cmd.Flags().GetString(flagPowerFlag)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Egresses(cmd.Context(), &types.QueryEgressesRequest{
+				PowerFlag:  powerFlag,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagPowerFlag, "", "only list egresses with this power flag (e.g. SMART_WALLET)")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "egresses")
+	return cmd
+}
+
+// GetCmdMailboxes lists the outbound mailboxes of peers
+func GetCmdMailboxes(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mailboxes",
+		Short: "list outbound mailboxes of peers",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			powerFlag, err := cmd.Flags().GetString(flagPowerFlag)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Mailboxes(cmd.Context(), &types.QueryMailboxesRequest{
+				PowerFlag:  powerFlag,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagPowerFlag, "", "only list mailboxes of peers whose egress has this power flag")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "mailboxes")
+	return cmd
+}
+
+// GetCmdInboundPrice queries the current price of inbound messages
+func GetCmdInboundPrice(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inbound-price",
+		Short: "get the current price of inbound messages under congestion pricing",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.InboundPrice(cmd.Context(), &types.QueryInboundPriceRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdBeansOwing queries the beans owed by an account
+func GetCmdBeansOwing(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "beans-owing <account>",
+		Short: "get beans owed but not yet paid by account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BeansOwing(cmd.Context(), &types.QueryBeansOwingRequest{
+				Address: addr,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdChargeHistory queries the recent fees charged to an account
+func GetCmdChargeHistory(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "charge-history <account>",
+		Short: "get recent fees charged to account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ChargeHistory(cmd.Context(), &types.QueryChargeHistoryRequest{
+				Address:    addr,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "charge-history")
+	return cmd
+}
+
+const flagHighPriority = "high-priority"
+
+// GetCmdInboundQueue queries the actions waiting in an inbound queue
+func GetCmdInboundQueue(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "queue",
+		Short: "get actions waiting in the inbound action queue and the queue limits",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			highPriority, err := cmd.Flags().GetBool(flagHighPriority)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.InboundQueue(cmd.Context(), &types.QueryInboundQueueRequest{
+				HighPriority: highPriority,
+				Pagination:   pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(flagHighPriority, false, "query the high-priority queue instead of the action queue")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "queue")
+	return cmd
+}
+
+// GetCmdScheduledAction queries a scheduled action that has not yet been
+// delivered
+func GetCmdScheduledAction(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scheduled-action <id>",
+		Short: "get a scheduled action",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ScheduledAction(cmd.Context(), &types.QueryScheduledActionRequest{
+				Id: id,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdScheduledActions queries the scheduled actions of an owner
+func GetCmdScheduledActions(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scheduled-actions <owner>",
+		Short: "get the scheduled actions of owner",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ScheduledActions(cmd.Context(), &types.QueryScheduledActionsRequest{
+				Owner:      owner,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "scheduled-actions")
+	return cmd
+}
+
+// GetCmdBundle queries the record of an installed bundle
+func GetCmdBundle(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bundle <id>",
+		Short: "get the record of an installed bundle",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Bundle(cmd.Context(), &types.QueryBundleRequest{
+				Id: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdBundles queries the records of installed bundles
+func GetCmdBundles(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bundles",
+		Short: "get the records of installed bundles",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Bundles(cmd.Context(), &types.QueryBundlesRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "bundles")
+	return cmd
+}
+
+// GetCmdBundleUpload queries the progress of a chunked bundle upload
+func GetCmdBundleUpload(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bundle-upload <upload-id>",
+		Short: "get the progress of a chunked bundle upload",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BundleUpload(cmd.Context(), &types.QueryBundleUploadRequest{
+				Id: id,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -1,10 +1,15 @@
 package cli
 
 import (
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -20,8 +25,12 @@ import (
 )
 
 const (
-	FlagAllowSpend = "allow-spend"
-	FlagCompress   = "compress"
+	FlagAllowSpend      = "allow-spend"
+	FlagChunkSize       = "chunk-size"
+	FlagCompress        = "compress"
+	FlagNotBeforeHeight = "not-before-height"
+	FlagNotBeforeTime   = "not-before-time"
+	FlagEscrow          = "escrow"
 )
 
 func GetTxCmd(storeKey string) *cobra.Command {
@@ -37,7 +46,13 @@ func GetTxCmd(storeKey string) *cobra.Command {
 		GetCmdDeliver(),
 		GetCmdProvisionOne(),
 		GetCmdInstallBundle(),
+		GetCmdBeginBundleUpload(),
+		GetCmdSendBundleChunk(),
+		GetCmdFinalizeBundleUpload(),
 		GetCmdWalletAction(),
+		GetCmdWalletActionBatch(),
+		GetCmdScheduleAction(),
+		GetCmdCancelScheduledAction(),
 	)
 
 	return swingsetTxCmd
@@ -158,6 +173,155 @@ https://github.com/endojs/endo/tree/master/packages/bundle-source`,
 	return cmd
 }
 
+// readBundleUploadData returns the data to upload for a bundle file, and its
+// uncompressed size if it was compressed.
+func readBundleUploadData(fname string, compress bool) ([]byte, int64, error) {
+	bundleBytes, err := os.ReadFile(fname)
+	if err != nil {
+		return nil, 0, err
+	}
+	if !compress {
+		return bundleBytes, 0, nil
+	}
+	msg := types.NewMsgInstallBundle(string(bundleBytes), nil)
+	if err := msg.Compress(); err != nil {
+		return nil, 0, err
+	}
+	return msg.CompressedBundle, msg.UncompressedSize, nil
+}
+
+// GetCmdBeginBundleUpload is the CLI command for beginning a chunked upload of
+// a bundle too large for install-bundle.
+func GetCmdBeginBundleUpload() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "begin-bundle-upload <bundle file>",
+		Short: "begin a chunked upload of a bundle",
+		Long: `begin a chunked upload of a bundle.
+The upload ID is in the transaction response.  Send the chunks of the same
+bundle file with send-bundle-chunk, using the same --compress flag, and then
+install the bundle with finalize-bundle-upload.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			compress, err := cmd.Flags().GetBool(FlagCompress)
+			if err != nil {
+				return err
+			}
+			data, uncompressedSize, err := readBundleUploadData(args[0], compress)
+			if err != nil {
+				return err
+			}
+			digest := sha512.Sum512(data)
+
+			msg := types.NewMsgBeginBundleUpload(clientCtx.GetFromAddress(), hex.EncodeToString(digest[:]), int64(len(data)), uncompressedSize)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagCompress, true, "Compress the bundle in transit")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSendBundleChunk is the CLI command for sending a chunk of a bundle
+// upload.
+func GetCmdSendBundleChunk() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-bundle-chunk <upload-id> <index> <bundle file>",
+		Short: "send a chunk of a bundle upload",
+		Long: `send a chunk of a bundle upload.
+Chunk <index> of the bundle file is the index-th run of --chunk-size bytes of
+the data to upload, and must be sent in order starting at 0.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			uploadID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			index, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			compress, err := cmd.Flags().GetBool(FlagCompress)
+			if err != nil {
+				return err
+			}
+			chunkSize, err := cmd.Flags().GetUint64(FlagChunkSize)
+			if err != nil {
+				return err
+			}
+			if chunkSize == 0 {
+				return fmt.Errorf("--%s must be positive", FlagChunkSize)
+			}
+			data, _, err := readBundleUploadData(args[2], compress)
+			if err != nil {
+				return err
+			}
+			start := index * chunkSize
+			if start >= uint64(len(data)) {
+				return fmt.Errorf("chunk %d is past the end of the %d bytes to upload", index, len(data))
+			}
+			end := start + chunkSize
+			if end > uint64(len(data)) {
+				end = uint64(len(data))
+			}
+
+			msg := types.NewMsgSendBundleChunk(clientCtx.GetFromAddress(), uploadID, index, data[start:end])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagCompress, true, "Compress the bundle in transit")
+	cmd.Flags().Uint64(FlagChunkSize, 512*1024, "Size in bytes of each chunk")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdFinalizeBundleUpload is the CLI command for installing the bundle of a
+// completed bundle upload.
+func GetCmdFinalizeBundleUpload() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finalize-bundle-upload <upload-id>",
+		Short: "install the bundle of a completed bundle upload",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			uploadID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFinalizeBundleUpload(clientCtx.GetFromAddress(), uploadID)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdProvision is the CLI command for sending a Provision transaction
 func GetCmdProvisionOne() *cobra.Command {
 	cmd := &cobra.Command{
@@ -234,6 +398,151 @@ func GetCmdWalletAction() *cobra.Command {
 	return cmd
 }
 
+// GetCmdWalletActionBatch is the CLI command for sending a WalletActionBatch
+// transaction.
+func GetCmdWalletActionBatch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wallet-action-batch {<actions JSON> | @- | @<file>}",
+		Short: "perform a batch of wallet actions",
+		Long: `perform a batch of wallet actions.
+The argument indicates how to read input JSON ("@-" for standard input,
+"@..." for a file path, and otherwise directly as in
+"wallet-action-batch '[...]'").
+Input must represent an array of {"id": string, "action": string, "spend": boolean}
+records, in which each action is JSON-stringified marshalled data.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			jsonIn := args[0]
+			if strings.HasPrefix(jsonIn, "@") {
+				var jsonBytes []byte
+				fname := jsonIn[1:]
+				if fname == "-" {
+					jsonBytes, err = io.ReadAll(os.Stdin)
+				} else {
+					jsonBytes, err = os.ReadFile(fname)
+				}
+				if err != nil {
+					return err
+				}
+				jsonIn = string(jsonBytes)
+			}
+			var actions []types.WalletBatchAction
+			if err := json.Unmarshal([]byte(jsonIn), &actions); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWalletActionBatch(clientCtx.GetFromAddress(), actions)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdScheduleAction is the CLI command for sending a ScheduleAction
+// transaction of a wallet action.
+func GetCmdScheduleAction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule-action <action JSON>",
+		Short: "schedule a wallet action for a later block",
+		Long: `schedule a wallet action for delivery at the start of the first block at or
+after --not-before-height or --not-before-time (RFC 3339 or Unix seconds).
+The --escrow coins are paid as the fee of the action when it is delivered,
+and refunded if it is cancelled.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			spend, err := cmd.Flags().GetBool(FlagAllowSpend)
+			if err != nil {
+				return err
+			}
+			notBeforeHeight, err := cmd.Flags().GetInt64(FlagNotBeforeHeight)
+			if err != nil {
+				return err
+			}
+			notBeforeTimeStr, err := cmd.Flags().GetString(FlagNotBeforeTime)
+			if err != nil {
+				return err
+			}
+			var notBeforeTime int64
+			if notBeforeTimeStr != "" {
+				notBeforeTime, err = strconv.ParseInt(notBeforeTimeStr, 10, 64)
+				if err != nil {
+					t, terr := time.Parse(time.RFC3339, notBeforeTimeStr)
+					if terr != nil {
+						return fmt.Errorf("invalid --%s %q", FlagNotBeforeTime, notBeforeTimeStr)
+					}
+					notBeforeTime = t.Unix()
+				}
+			}
+			escrowStr, err := cmd.Flags().GetString(FlagEscrow)
+			if err != nil {
+				return err
+			}
+			escrow, err := sdk.ParseCoinsNormalized(escrowStr)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgScheduleWalletAction(clientCtx.GetFromAddress(), args[0], spend, notBeforeHeight, notBeforeTime, escrow)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagAllowSpend, false, "Allow the scheduled action to spend assets")
+	cmd.Flags().Int64(FlagNotBeforeHeight, 0, "Block height at which to deliver the action")
+	cmd.Flags().String(FlagNotBeforeTime, "", "Block time at which to deliver the action")
+	cmd.Flags().String(FlagEscrow, "", "Coins to escrow for the fee of the action")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdCancelScheduledAction is the CLI command for sending a
+// CancelScheduledAction transaction.
+func GetCmdCancelScheduledAction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-scheduled-action <id>",
+		Short: "cancel a scheduled action and refund its escrow",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelScheduledAction(clientCtx.GetFromAddress(), id)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // NewCmdSubmitCoreEvalProposal is the CLI command for submitting a "CoreEval"
 // governance proposal via `agd tx gov submit-proposal swingset-core-eval ...`.
 func NewCmdSubmitCoreEvalProposal() *cobra.Command {
@@ -23,6 +23,42 @@ func ValidateGenesis(data *types.GenesisState) error {
 	if err := data.Params.ValidateBasic(); err != nil {
 		return err
 	}
+	chargedAddrs := map[string]bool{}
+	for _, history := range data.ChargeHistory {
+		if history.Address.Empty() {
+			return fmt.Errorf("charge history has no address")
+		}
+		if chargedAddrs[string(history.Address)] {
+			return fmt.Errorf("duplicate charge history for %s", history.Address)
+		}
+		chargedAddrs[string(history.Address)] = true
+		if len(history.Charges) > keeper.MaxChargeHistoryPerAddress {
+			return fmt.Errorf("charge history for %s has more than %d charges", history.Address, keeper.MaxChargeHistoryPerAddress)
+		}
+	}
+	scheduledIDs := map[uint64]bool{}
+	for _, sa := range data.ScheduledActions {
+		if scheduledIDs[sa.Id] {
+			return fmt.Errorf("duplicate scheduled action %d", sa.Id)
+		}
+		scheduledIDs[sa.Id] = true
+		if sa.Owner.Empty() {
+			return fmt.Errorf("scheduled action %d has no owner", sa.Id)
+		}
+		if (sa.NotBeforeHeight > 0) == (sa.NotBeforeTime > 0) {
+			return fmt.Errorf("scheduled action %d must have exactly one of not-before height or time", sa.Id)
+		}
+	}
+	bundleIDs := map[string]bool{}
+	for _, bundle := range data.InstalledBundles {
+		if bundleIDs[bundle.Id] {
+			return fmt.Errorf("duplicate installed bundle %s", bundle.Id)
+		}
+		bundleIDs[bundle.Id] = true
+		if !strings.HasPrefix(bundle.Id, "b1-") {
+			return fmt.Errorf("installed bundle %q has an invalid ID", bundle.Id)
+		}
+	}
 	return nil
 }
 
@@ -35,10 +71,15 @@ func DefaultGenesisState() *types.GenesisState {
 }
 
 // InitGenesis initializes the (Cosmos-side) SwingSet state from the GenesisState.
+// Any swing-store export is restored from swingStoreExportDir with the given
+// artifact mode.
 // Returns whether the app should send a bootstrap action to the controller.
-func InitGenesis(ctx sdk.Context, k Keeper, swingStoreExportsHandler *SwingStoreExportsHandler, swingStoreExportDir string, data *types.GenesisState) bool {
+func InitGenesis(ctx sdk.Context, k Keeper, swingStoreExportsHandler *SwingStoreExportsHandler, swingStoreExportDir string, swingStoreArtifactMode string, data *types.GenesisState) bool {
 	k.SetParams(ctx, data.GetParams())
 	k.SetState(ctx, data.GetState())
+	k.SetChargeHistory(ctx, data.GetChargeHistory())
+	k.SetScheduledActions(ctx, data.GetScheduledActions())
+	k.SetInstalledBundles(ctx, data.GetInstalledBundles())
 
 	swingStoreExportData := data.GetSwingStoreExportData()
 	if len(swingStoreExportData) == 0 && data.SwingStoreExportDataHash == "" {
@@ -47,6 +88,15 @@ func InitGenesis(ctx sdk.Context, k Keeper, swingStoreExportsHandler *SwingStore
 		panic("Swingset genesis state cannot have both export data and hash of export data")
 	}
 
+	restoreOptions := keeper.SwingStoreRestoreOptions{
+		ArtifactMode:   swingStoreArtifactMode,
+		ExportDataMode: keeper.SwingStoreExportDataModeAll,
+	}
+	err := keeper.ValidateSwingStoreRestoreOptions(restoreOptions)
+	if err != nil {
+		panic(err)
+	}
+
 	artifactProvider, err := keeper.OpenSwingStoreExportDirectory(swingStoreExportDir)
 	if err != nil {
 		panic(err)
@@ -117,11 +167,9 @@ func InitGenesis(ctx sdk.Context, k Keeper, swingStoreExportsHandler *SwingStore
 			BlockHeight:         snapshotHeight,
 			GetExportDataReader: getExportDataReader,
 			ReadNextArtifact:    artifactProvider.ReadNextArtifact,
+			OpenNextArtifact:    artifactProvider.OpenNextArtifact,
 		},
-		keeper.SwingStoreRestoreOptions{
-			ArtifactMode:   keeper.SwingStoreArtifactModeOperational,
-			ExportDataMode: keeper.SwingStoreExportDataModeAll,
-		},
+		restoreOptions,
 	)
 	if err != nil {
 		panic(err)
@@ -130,24 +178,31 @@ func InitGenesis(ctx sdk.Context, k Keeper, swingStoreExportsHandler *SwingStore
 	return false
 }
 
-func ExportGenesis(ctx sdk.Context, k Keeper, swingStoreExportsHandler *SwingStoreExportsHandler, swingStoreExportDir string) *types.GenesisState {
+// ExportGenesis exports the (Cosmos-side) SwingSet state, and saves the
+// swing-store export to swingStoreExportDir.  If swingStoreExportParentDir is
+// set, the swing-store export is incremental from the export it contains.
+func ExportGenesis(ctx sdk.Context, k Keeper, swingStoreExportsHandler *SwingStoreExportsHandler, swingStoreExportDir string, swingStoreExportParentDir string) *types.GenesisState {
 	gs := &types.GenesisState{
 		Params:               k.GetParams(ctx),
 		State:                k.GetState(ctx),
 		SwingStoreExportData: nil,
+		ChargeHistory:        k.GetAllChargeHistory(ctx),
+		ScheduledActions:     k.GetAllScheduledActions(ctx),
+		InstalledBundles:     k.GetAllInstalledBundles(ctx),
 	}
 
 	snapshotHeight := uint64(ctx.BlockHeight())
 
-	eventHandler := swingStoreGenesisEventHandler{exportDir: swingStoreExportDir, snapshotHeight: snapshotHeight, swingStore: k.GetSwingStore(ctx), hasher: sha256.New()}
+	eventHandler := swingStoreGenesisEventHandler{exportDir: swingStoreExportDir, parentExportDir: swingStoreExportParentDir, snapshotHeight: snapshotHeight, swingStore: k.GetSwingStore(ctx), hasher: sha256.New()}
 
 	err := swingStoreExportsHandler.InitiateExport(
 		// The export will fail if the export of a historical height was requested
 		snapshotHeight,
 		eventHandler,
 		keeper.SwingStoreExportOptions{
-			ArtifactMode:   keeper.SwingStoreArtifactModeOperational,
-			ExportDataMode: keeper.SwingStoreExportDataModeSkip,
+			ArtifactMode:    keeper.SwingStoreArtifactModeOperational,
+			ExportDataMode:  keeper.SwingStoreExportDataModeSkip,
+			ParentExportDir: swingStoreExportParentDir,
 		},
 	)
 	if err != nil {
@@ -165,10 +220,11 @@ func ExportGenesis(ctx sdk.Context, k Keeper, swingStoreExportsHandler *SwingSto
 }
 
 type swingStoreGenesisEventHandler struct {
-	exportDir      string
-	snapshotHeight uint64
-	swingStore     sdk.KVStore
-	hasher         hash.Hash
+	exportDir       string
+	parentExportDir string
+	snapshotHeight  uint64
+	swingStore      sdk.KVStore
+	hasher          hash.Hash
 }
 
 func (eventHandler swingStoreGenesisEventHandler) OnExportStarted(height uint64, retrieveSwingStoreExport func() error) error {
@@ -181,6 +237,7 @@ func (eventHandler swingStoreGenesisEventHandler) OnExportRetrieved(provider kee
 	}
 
 	artifactsProvider := keeper.SwingStoreExportProvider{
+		BlockHeight: provider.BlockHeight,
 		GetExportDataReader: func() (agoric.KVEntryReader, error) {
 			exportDataIterator := eventHandler.swingStore.Iterator(nil, nil)
 			kvReader := agoric.NewKVIteratorReader(exportDataIterator)
@@ -195,7 +252,12 @@ func (eventHandler swingStoreGenesisEventHandler) OnExportRetrieved(provider kee
 			}), nil
 		},
 		ReadNextArtifact: provider.ReadNextArtifact,
+		OpenNextArtifact: provider.OpenNextArtifact,
+		ParentArtifacts:  provider.ParentArtifacts,
 	}
 
+	if eventHandler.parentExportDir != "" {
+		return keeper.WriteIncrementalSwingStoreExportToDirectory(artifactsProvider, eventHandler.exportDir, eventHandler.parentExportDir)
+	}
 	return keeper.WriteSwingStoreExportToDirectory(artifactsProvider, eventHandler.exportDir)
 }
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const inboundAdmissionsKeyPrefix = "inboundAdmissions."
+
+// GetInboundAdmissions returns the number of inbound messages admitted so far
+// in the current block (or since the last commit, for mempool admission)
+// against a per-block budget key.
+// The counts are kept in the transient store, so they are reset on commit and
+// are rolled back along with a Tx that fails admission.
+func (k Keeper) GetInboundAdmissions(ctx sdk.Context, key string) int32 {
+	store := ctx.TransientStore(k.tStoreKey)
+	bz := store.Get([]byte(inboundAdmissionsKeyPrefix + key))
+	if bz == nil {
+		return 0
+	}
+	return int32(binary.BigEndian.Uint32(bz))
+}
+
+// AddInboundAdmissions adds to the number of inbound messages admitted in the
+// current block against a per-block budget key.
+func (k Keeper) AddInboundAdmissions(ctx sdk.Context, key string, count int32) {
+	total := k.GetInboundAdmissions(ctx, key) + count
+	store := ctx.TransientStore(k.tStoreKey)
+	store.Set([]byte(inboundAdmissionsKeyPrefix+key), binary.BigEndian.AppendUint32(nil, uint32(total)))
+}
@@ -0,0 +1,245 @@
+package keeper
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+const (
+	bundleUploadSeqKey            = "bundleUploadSeq"
+	bundleUploadKeyPrefix         = "bundleUpload."
+	bundleChunkKeyPrefix          = "bundleChunk."
+	bundleUploadByExpiryKeyPrefix = "bundleUploadByExpiry."
+	bundleUploadBySubmitterPrefix = "bundleUploadBySubmitter."
+
+	// BundleUploadLifetimeBlocks is the number of blocks after it begins in
+	// which a bundle upload must be finalized.
+	BundleUploadLifetimeBlocks = 1000
+
+	// MaxExpiredBundleUploadsPerBlock is the number of expired bundle uploads
+	// discarded at the start of a block.  Any others are discarded in following
+	// blocks.
+	MaxExpiredBundleUploadsPerBlock = 10
+
+	// ExpiredBundleUploadsPrunedPerBegin is the number of expired bundle
+	// uploads discarded when a bundle upload begins, so that discarding keeps
+	// pace with new uploads.
+	ExpiredBundleUploadsPrunedPerBegin = 2
+
+	// MaxOpenBundleUploadsPerSubmitter is the number of unexpired bundle
+	// uploads that a submitter may have begun without finalizing.
+	MaxOpenBundleUploadsPerSubmitter = 4
+)
+
+func bundleUploadKey(id uint64) []byte {
+	return append([]byte(bundleUploadKeyPrefix), uint64Key(id)...)
+}
+
+func bundleChunkPrefix(id uint64) []byte {
+	return append([]byte(bundleChunkKeyPrefix), uint64Key(id)...)
+}
+
+func bundleUploadsBySubmitterPrefix(submitter sdk.AccAddress) []byte {
+	return append([]byte(bundleUploadBySubmitterPrefix), address.MustLengthPrefix(submitter)...)
+}
+
+func bundleUploadExpiryKey(upload types.BundleUpload) []byte {
+	return append(append([]byte(bundleUploadByExpiryKeyPrefix), uint64Key(uint64(upload.ExpiryHeight))...), uint64Key(upload.Id)...)
+}
+
+func (k Keeper) setBundleUpload(ctx sdk.Context, upload types.BundleUpload) {
+	ctx.KVStore(k.storeKey).Set(bundleUploadKey(upload.Id), k.cdc.MustMarshal(&upload))
+}
+
+// deleteBundleUpload forgets a bundle upload and its chunks.
+func (k Keeper) deleteBundleUpload(ctx sdk.Context, upload types.BundleUpload) {
+	store := ctx.KVStore(k.storeKey)
+	chunkStore := prefix.NewStore(store, bundleChunkPrefix(upload.Id))
+	for i := uint64(0); i < upload.ChunksReceived; i++ {
+		chunkStore.Delete(uint64Key(i))
+	}
+	store.Delete(bundleUploadKey(upload.Id))
+	store.Delete(bundleUploadExpiryKey(upload))
+	store.Delete(append(bundleUploadsBySubmitterPrefix(upload.Submitter), uint64Key(upload.Id)...))
+}
+
+// submitterBundleUploads returns the bundle uploads of a submitter that have
+// been neither finalized nor discarded, in order of ID.
+func (k Keeper) submitterBundleUploads(ctx sdk.Context, submitter sdk.AccAddress) ([]types.BundleUpload, error) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), bundleUploadsBySubmitterPrefix(submitter))
+	defer iterator.Close()
+
+	uploads := []types.BundleUpload{}
+	for ; iterator.Valid(); iterator.Next() {
+		id := binary.BigEndian.Uint64(iterator.Key()[len(iterator.Key())-8:])
+		upload, found := k.GetBundleUpload(ctx, id)
+		if !found {
+			return nil, fmt.Errorf("missing bundle upload %d", id)
+		}
+		uploads = append(uploads, upload)
+	}
+	return uploads, nil
+}
+
+// AddBundleUpload begins a chunked upload of data with the given SHA-512 and
+// size, which expires after BundleUploadLifetimeBlocks.  It returns the ID of
+// the upload, or an error if the submitter already has
+// MaxOpenBundleUploadsPerSubmitter unexpired uploads.  Expired uploads of the
+// submitter, and up to ExpiredBundleUploadsPrunedPerBegin others, are
+// discarded.
+func (k Keeper) AddBundleUpload(ctx sdk.Context, submitter sdk.AccAddress, sha512Hex string, size, uncompressedSize int64) (uint64, error) {
+	if err := k.pruneExpiredBundleUploads(ctx, ExpiredBundleUploadsPrunedPerBegin); err != nil {
+		return 0, err
+	}
+	uploads, err := k.submitterBundleUploads(ctx, submitter)
+	if err != nil {
+		return 0, err
+	}
+	open := 0
+	for _, upload := range uploads {
+		if ctx.BlockHeight() > upload.ExpiryHeight {
+			k.deleteBundleUpload(ctx, upload)
+		} else {
+			open++
+		}
+	}
+	if open >= MaxOpenBundleUploadsPerSubmitter {
+		return 0, fmt.Errorf("%s already has %d open bundle uploads", submitter, open)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	var id uint64
+	if bz := store.Get([]byte(bundleUploadSeqKey)); bz != nil {
+		id = binary.BigEndian.Uint64(bz)
+	}
+	store.Set([]byte(bundleUploadSeqKey), uint64Key(id+1))
+
+	upload := types.BundleUpload{
+		Id:               id,
+		Submitter:        submitter,
+		Sha512:           sha512Hex,
+		Size_:            size,
+		UncompressedSize: uncompressedSize,
+		ExpiryHeight:     ctx.BlockHeight() + BundleUploadLifetimeBlocks,
+	}
+	k.setBundleUpload(ctx, upload)
+	store.Set(bundleUploadExpiryKey(upload), []byte{})
+	store.Set(append(bundleUploadsBySubmitterPrefix(submitter), uint64Key(id)...), []byte{})
+	return id, nil
+}
+
+// GetBundleUpload returns a bundle upload that has been neither finalized nor
+// discarded.
+func (k Keeper) GetBundleUpload(ctx sdk.Context, id uint64) (types.BundleUpload, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(bundleUploadKey(id))
+	if bz == nil {
+		return types.BundleUpload{}, false
+	}
+	var upload types.BundleUpload
+	k.cdc.MustUnmarshal(bz, &upload)
+	return upload, true
+}
+
+// getSubmittedBundleUpload returns an unexpired bundle upload of a submitter.
+func (k Keeper) getSubmittedBundleUpload(ctx sdk.Context, submitter sdk.AccAddress, id uint64) (types.BundleUpload, error) {
+	upload, found := k.GetBundleUpload(ctx, id)
+	if !found || !upload.Submitter.Equals(submitter) {
+		return types.BundleUpload{}, fmt.Errorf("no bundle upload %d of %s", id, submitter)
+	}
+	if ctx.BlockHeight() > upload.ExpiryHeight {
+		return types.BundleUpload{}, fmt.Errorf("bundle upload %d expired at height %d", id, upload.ExpiryHeight)
+	}
+	return upload, nil
+}
+
+// AddBundleChunk stores the next chunk of a bundle upload of a submitter.
+func (k Keeper) AddBundleChunk(ctx sdk.Context, submitter sdk.AccAddress, id, index uint64, data []byte) error {
+	upload, err := k.getSubmittedBundleUpload(ctx, submitter, id)
+	if err != nil {
+		return err
+	}
+	if index != upload.ChunksReceived {
+		return fmt.Errorf("bundle upload %d expects chunk %d, not %d", id, upload.ChunksReceived, index)
+	}
+	if upload.BytesReceived+int64(len(data)) > upload.Size_ {
+		return fmt.Errorf("chunk %d overflows bundle upload %d size %d", index, id, upload.Size_)
+	}
+
+	chunkStore := prefix.NewStore(ctx.KVStore(k.storeKey), bundleChunkPrefix(id))
+	chunkStore.Set(uint64Key(index), data)
+	upload.ChunksReceived++
+	upload.BytesReceived += int64(len(data))
+	k.setBundleUpload(ctx, upload)
+	return nil
+}
+
+// CompleteBundleUpload assembles and forgets a fully received bundle upload of
+// a submitter, returning its data as a MsgInstallBundle once it has been
+// verified against the SHA-512 with which the upload began.
+func (k Keeper) CompleteBundleUpload(ctx sdk.Context, submitter sdk.AccAddress, id uint64) (*types.MsgInstallBundle, error) {
+	upload, err := k.getSubmittedBundleUpload(ctx, submitter, id)
+	if err != nil {
+		return nil, err
+	}
+	if upload.BytesReceived != upload.Size_ {
+		return nil, fmt.Errorf("bundle upload %d has received %d of %d bytes", id, upload.BytesReceived, upload.Size_)
+	}
+
+	var buf bytes.Buffer
+	buf.Grow(int(upload.Size_))
+	chunkStore := prefix.NewStore(ctx.KVStore(k.storeKey), bundleChunkPrefix(id))
+	for i := uint64(0); i < upload.ChunksReceived; i++ {
+		buf.Write(chunkStore.Get(uint64Key(i)))
+	}
+	k.deleteBundleUpload(ctx, upload)
+
+	digest := sha512.Sum512(buf.Bytes())
+	if hex.EncodeToString(digest[:]) != upload.Sha512 {
+		return nil, fmt.Errorf("bundle upload %d does not match its SHA-512", id)
+	}
+
+	msg := &types.MsgInstallBundle{Submitter: submitter}
+	if upload.UncompressedSize > 0 {
+		msg.CompressedBundle = buf.Bytes()
+		msg.UncompressedSize = upload.UncompressedSize
+	} else {
+		msg.Bundle = buf.String()
+	}
+	return msg, nil
+}
+
+// PruneExpiredBundleUploads discards up to MaxExpiredBundleUploadsPerBlock
+// bundle uploads which have expired before the current block height.
+func (k Keeper) PruneExpiredBundleUploads(ctx sdk.Context) error {
+	return k.pruneExpiredBundleUploads(ctx, MaxExpiredBundleUploadsPerBlock)
+}
+
+// pruneExpiredBundleUploads discards up to limit bundle uploads which have
+// expired before the current block height.
+func (k Keeper) pruneExpiredBundleUploads(ctx sdk.Context, limit int) error {
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(bundleUploadByExpiryKeyPrefix))
+	iterator := indexStore.Iterator(nil, uint64Key(uint64(ctx.BlockHeight())))
+	ids := []uint64{}
+	for ; iterator.Valid() && len(ids) < limit; iterator.Next() {
+		ids = append(ids, binary.BigEndian.Uint64(iterator.Key()[8:]))
+	}
+	iterator.Close()
+
+	for _, id := range ids {
+		upload, found := k.GetBundleUpload(ctx, id)
+		if !found {
+			return fmt.Errorf("missing bundle upload %d", id)
+		}
+		k.deleteBundleUpload(ctx, upload)
+	}
+	return nil
+}
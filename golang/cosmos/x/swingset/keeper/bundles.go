@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+const installedBundleKeyPrefix = "installedBundle."
+
+// getInstalledBundleStore returns the store of installed bundle records, keyed
+// by bundle ID.
+func (k Keeper) getInstalledBundleStore(ctx sdk.Context) sdk.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), []byte(installedBundleKeyPrefix))
+}
+
+// RecordInstalledBundle records a bundle that the controller has installed,
+// unless it was already installed.
+func (k Keeper) RecordInstalledBundle(ctx sdk.Context, id string, submitter sdk.AccAddress, size int64) {
+	store := k.getInstalledBundleStore(ctx)
+	if store.Has([]byte(id)) {
+		return
+	}
+	bundle := types.InstalledBundle{
+		Id:          id,
+		Submitter:   submitter,
+		Size_:       size,
+		BlockHeight: ctx.BlockHeight(),
+	}
+	store.Set([]byte(id), k.cdc.MustMarshal(&bundle))
+}
+
+// GetInstalledBundle returns the record of an installed bundle.
+func (k Keeper) GetInstalledBundle(ctx sdk.Context, id string) (types.InstalledBundle, bool) {
+	bz := k.getInstalledBundleStore(ctx).Get([]byte(id))
+	if bz == nil {
+		return types.InstalledBundle{}, false
+	}
+	var bundle types.InstalledBundle
+	k.cdc.MustUnmarshal(bz, &bundle)
+	return bundle, true
+}
+
+// PaginateInstalledBundles calls onResult with the record of each installed
+// bundle in order of bundle ID, subject to pageRequest.
+func (k Keeper) PaginateInstalledBundles(
+	ctx sdk.Context,
+	pageRequest *query.PageRequest,
+	onResult func(bundle types.InstalledBundle) error,
+) (*query.PageResponse, error) {
+	return query.Paginate(k.getInstalledBundleStore(ctx), pageRequest, func(_ []byte, value []byte) error {
+		var bundle types.InstalledBundle
+		if err := k.cdc.Unmarshal(value, &bundle); err != nil {
+			return err
+		}
+		return onResult(bundle)
+	})
+}
+
+// GetAllInstalledBundles returns the record of every installed bundle in order
+// of bundle ID.
+func (k Keeper) GetAllInstalledBundles(ctx sdk.Context) []types.InstalledBundle {
+	iterator := k.getInstalledBundleStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+
+	bundles := []types.InstalledBundle{}
+	for ; iterator.Valid(); iterator.Next() {
+		var bundle types.InstalledBundle
+		k.cdc.MustUnmarshal(iterator.Value(), &bundle)
+		bundles = append(bundles, bundle)
+	}
+	return bundles
+}
+
+// SetInstalledBundles stores installed bundle records from genesis.
+func (k Keeper) SetInstalledBundles(ctx sdk.Context, bundles []types.InstalledBundle) {
+	store := k.getInstalledBundleStore(ctx)
+	for _, bundle := range bundles {
+		bundle := bundle
+		store.Set([]byte(bundle.Id), k.cdc.MustMarshal(&bundle))
+	}
+}
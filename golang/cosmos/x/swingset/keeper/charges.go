@@ -0,0 +1,174 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+const (
+	chargeHistoryKeyPrefix       = "chargeHistory."
+	chargeHistorySeqKeyPrefix    = "chargeHistorySeq."
+	chargeHistoryExpiryKeyPrefix = "chargeHistoryExpiry."
+
+	// MaxChargeHistoryPerAddress is the number of most recent charges retained
+	// in the charge history of each address.
+	MaxChargeHistoryPerAddress = 100
+
+	// ChargeHistoryRetentionBlocks is the number of blocks for which a charge
+	// is retained in the charge history, bounding the size of the history of
+	// all addresses by the number of charges in that many blocks.
+	ChargeHistoryRetentionBlocks = 100_000
+)
+
+func uint64Key(n uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, n)
+}
+
+// getChargeHistoryStore returns the store of an address's charge records,
+// keyed by big-endian sequence number.
+func (k Keeper) getChargeHistoryStore(ctx sdk.Context, addr sdk.AccAddress) sdk.KVStore {
+	store := ctx.KVStore(k.storeKey)
+	return prefix.NewStore(store, append([]byte(chargeHistoryKeyPrefix), address.MustLengthPrefix(addr)...))
+}
+
+func chargeHistorySeqKey(addr sdk.AccAddress) []byte {
+	return append([]byte(chargeHistorySeqKeyPrefix), address.MustLengthPrefix(addr)...)
+}
+
+// chargeHistoryExpiryKey indexes a charge record by the block height in which
+// it was charged, for pruning.
+func chargeHistoryExpiryKey(blockHeight int64, addr sdk.AccAddress, seq uint64) []byte {
+	key := append([]byte(chargeHistoryExpiryKeyPrefix), uint64Key(uint64(blockHeight))...)
+	key = append(key, address.MustLengthPrefix(addr)...)
+	return append(key, uint64Key(seq)...)
+}
+
+// recordCharge appends a charge to the history of an address, completing it
+// with the context of the charge and forgetting the oldest charge if the
+// history is full.
+func (k Keeper) recordCharge(ctx sdk.Context, addr sdk.AccAddress, record types.ChargeRecord) {
+	txHash, ok := ctx.Context().Value(baseapp.TxHashContextKey).(string)
+	if !ok {
+		txHash = "unknown"
+	}
+	record.BlockHeight = ctx.BlockHeight()
+	record.TxHash = txHash
+	k.appendChargeRecord(ctx, addr, record)
+}
+
+// appendChargeRecord adds a complete charge record to the history of an
+// address, forgetting the oldest charge if the history is full.
+func (k Keeper) appendChargeRecord(ctx sdk.Context, addr sdk.AccAddress, record types.ChargeRecord) {
+	store := ctx.KVStore(k.storeKey)
+	seqKey := chargeHistorySeqKey(addr)
+	var seq uint64
+	if bz := store.Get(seqKey); bz != nil {
+		seq = binary.BigEndian.Uint64(bz)
+	}
+	store.Set(seqKey, binary.BigEndian.AppendUint64(nil, seq+1))
+
+	history := k.getChargeHistoryStore(ctx, addr)
+	history.Set(binary.BigEndian.AppendUint64(nil, seq), k.cdc.MustMarshal(&record))
+	store.Set(chargeHistoryExpiryKey(record.BlockHeight, addr, seq), []byte{})
+	if seq >= MaxChargeHistoryPerAddress {
+		oldestKey := binary.BigEndian.AppendUint64(nil, seq-MaxChargeHistoryPerAddress)
+		if bz := history.Get(oldestKey); bz != nil {
+			var oldest types.ChargeRecord
+			k.cdc.MustUnmarshal(bz, &oldest)
+			store.Delete(chargeHistoryExpiryKey(oldest.BlockHeight, addr, seq-MaxChargeHistoryPerAddress))
+			history.Delete(oldestKey)
+		}
+	}
+}
+
+// PruneChargeHistory forgets the charges made more than
+// ChargeHistoryRetentionBlocks blocks ago, and the sequence number of each
+// address left without any retained charge.
+func (k Keeper) PruneChargeHistory(ctx sdk.Context) {
+	cutoff := ctx.BlockHeight() - ChargeHistoryRetentionBlocks
+	if cutoff < 0 {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	expiryStore := prefix.NewStore(store, []byte(chargeHistoryExpiryKeyPrefix))
+	iterator := expiryStore.Iterator(nil, uint64Key(uint64(cutoff)+1))
+	expiredKeys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		expiredKeys = append(expiredKeys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range expiredKeys {
+		expiryStore.Delete(key)
+		addrLen := int(key[8])
+		addr := sdk.AccAddress(key[9 : 9+addrLen])
+		seqBz := key[9+addrLen:]
+		k.getChargeHistoryStore(ctx, addr).Delete(seqBz)
+
+		// Charges expire in sequence order, so the history is empty once its
+		// most recent charge has expired.
+		seqKey := chargeHistorySeqKey(addr)
+		if bz := store.Get(seqKey); bz != nil && binary.BigEndian.Uint64(bz) == binary.BigEndian.Uint64(seqBz)+1 {
+			store.Delete(seqKey)
+		}
+	}
+}
+
+// GetAllChargeHistory returns the retained charge history of every address.
+func (k Keeper) GetAllChargeHistory(ctx sdk.Context) []types.AccountChargeHistory {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(chargeHistorySeqKeyPrefix))
+	defer iterator.Close()
+
+	histories := []types.AccountChargeHistory{}
+	for ; iterator.Valid(); iterator.Next() {
+		lengthPrefixed := iterator.Key()[len(chargeHistorySeqKeyPrefix):]
+		history := types.AccountChargeHistory{
+			Address: sdk.AccAddress(lengthPrefixed[1:]),
+			Charges: []types.ChargeRecord{},
+		}
+		_, err := k.PaginateChargeHistory(ctx, history.Address, &query.PageRequest{Limit: MaxChargeHistoryPerAddress}, func(record types.ChargeRecord) error {
+			history.Charges = append(history.Charges, record)
+			return nil
+		})
+		if err != nil {
+			panic(err)
+		}
+		histories = append(histories, history)
+	}
+	return histories
+}
+
+// SetChargeHistory stores the charge histories from genesis.
+func (k Keeper) SetChargeHistory(ctx sdk.Context, histories []types.AccountChargeHistory) {
+	for _, history := range histories {
+		for _, record := range history.Charges {
+			k.appendChargeRecord(ctx, history.Address, record)
+		}
+	}
+}
+
+// PaginateChargeHistory calls onResult with each retained charge to an
+// address, oldest first, subject to pageRequest.
+func (k Keeper) PaginateChargeHistory(
+	ctx sdk.Context,
+	addr sdk.AccAddress,
+	pageRequest *query.PageRequest,
+	onResult func(record types.ChargeRecord) error,
+) (*query.PageResponse, error) {
+	history := k.getChargeHistoryStore(ctx, addr)
+	return query.Paginate(history, pageRequest, func(key []byte, value []byte) error {
+		var record types.ChargeRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+		return onResult(record)
+	})
+}
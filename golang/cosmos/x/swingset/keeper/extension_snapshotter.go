@@ -1,7 +1,10 @@
 package keeper
 
 import (
+	"bufio"
 	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"io"
@@ -22,8 +25,27 @@ import (
 var _ snapshots.ExtensionSnapshotter = &ExtensionSnapshotter{}
 var _ SwingStoreExportEventHandler = &ExtensionSnapshotter{}
 
-// SnapshotFormat 1 defines all extension payloads to be SwingStoreArtifact proto messages
-const SnapshotFormat = 1
+const (
+	// SnapshotFormatArtifacts (format 1) defines all extension payloads to be
+	// SwingStoreArtifact proto messages.
+	SnapshotFormatArtifacts = 1
+
+	// SnapshotFormatArtifactChunks (format 2) defines all extension payloads to
+	// be SwingStoreArtifactChunk proto messages, each artifact being split into
+	// consecutive chunks of at most SnapshotArtifactChunkSize bytes which are
+	// individually compressed and hashed.
+	SnapshotFormatArtifactChunks = 2
+
+	// DefaultSnapshotFormat is the format of the extension payloads of new
+	// snapshots unless configured otherwise.  It remains format 1 for one
+	// release, so that nodes which cannot restore format 2 can still state-sync
+	// from upgraded snapshot providers.
+	DefaultSnapshotFormat = SnapshotFormatArtifacts
+
+	// SnapshotArtifactChunkSize is the maximum uncompressed size in bytes of a
+	// SwingStoreArtifactChunk.
+	SnapshotArtifactChunkSize = 8 * 1024 * 1024
+)
 
 // snapshotDetails describes an in-progress state-sync snapshot
 type snapshotDetails struct {
@@ -69,6 +91,8 @@ type ExtensionSnapshotter struct {
 	getSwingStoreExportDataShadowCopyReader func(height int64) agoric.KVEntryReader
 	logger                                  log.Logger
 	activeSnapshot                          *snapshotDetails
+	// snapshotFormat is the format of the extension payloads of new snapshots.
+	snapshotFormat uint32
 }
 
 // NewExtensionSnapshotter creates a new swingset ExtensionSnapshotter
@@ -84,7 +108,18 @@ func NewExtensionSnapshotter(
 		swingStoreExportsHandler:                swingStoreExportsHandler,
 		getSwingStoreExportDataShadowCopyReader: getSwingStoreExportDataShadowCopyReader,
 		activeSnapshot:                          nil,
+		snapshotFormat:                          DefaultSnapshotFormat,
+	}
+}
+
+// SetSnapshotFormat sets the format of the extension payloads of new
+// snapshots, which must be one of the supported formats.
+func (snapshotter *ExtensionSnapshotter) SetSnapshotFormat(format uint32) error {
+	if format != SnapshotFormatArtifacts && format != SnapshotFormatArtifactChunks {
+		return fmt.Errorf("unsupported swingset snapshot format %d", format)
 	}
+	snapshotter.snapshotFormat = format
+	return nil
 }
 
 // SnapshotName returns the name of the snapshotter, it should be unique in the manager.
@@ -98,14 +133,14 @@ func (snapshotter *ExtensionSnapshotter) SnapshotName() string {
 // used for the overall state-sync snapshot.
 // Implements ExtensionSnapshotter
 func (snapshotter *ExtensionSnapshotter) SnapshotFormat() uint32 {
-	return SnapshotFormat
+	return snapshotter.snapshotFormat
 }
 
 // SupportedFormats returns a list of extension specific payload formats it can
 // restore from.
 // Implements ExtensionSnapshotter
 func (snapshotter *ExtensionSnapshotter) SupportedFormats() []uint32 {
-	return []uint32{SnapshotFormat}
+	return []uint32{SnapshotFormatArtifacts, SnapshotFormatArtifactChunks}
 }
 
 // InitiateSnapshot initiates a snapshot for the given block height.
@@ -213,29 +248,35 @@ func (snapshotter *ExtensionSnapshotter) OnExportRetrieved(provider SwingStoreEx
 		return fmt.Errorf("SwingStore export received for unexpected block height %d (app snapshot height is %d)", provider.BlockHeight, snapshotDetails.blockHeight)
 	}
 
-	writeArtifactToPayload := func(artifact types.SwingStoreArtifact) error {
-		payloadBytes, err := artifact.Marshal()
+	writeArtifactToPayload := func(artifactName string, artifactReader io.Reader) error {
+		if snapshotter.snapshotFormat == SnapshotFormatArtifactChunks {
+			return writeArtifactChunks(artifactName, artifactReader, SnapshotArtifactChunkSize, snapshotDetails.payloadWriter)
+		}
+
+		data, err := io.ReadAll(artifactReader)
 		if err != nil {
 			return err
 		}
-
-		err = snapshotDetails.payloadWriter(payloadBytes)
+		artifact := types.SwingStoreArtifact{Name: artifactName, Data: data}
+		payloadBytes, err := artifact.Marshal()
 		if err != nil {
 			return err
 		}
-
-		return nil
+		return snapshotDetails.payloadWriter(payloadBytes)
 	}
 
 	for {
-		artifact, err := provider.ReadNextArtifact()
+		artifactName, artifactReader, err := provider.openNextArtifact()
 		if err == io.EOF {
 			break
 		} else if err != nil {
 			return err
 		}
 
-		err = writeArtifactToPayload(artifact)
+		err = writeArtifactToPayload(artifactName, artifactReader)
+		if closeErr := artifactReader.Close(); err == nil {
+			err = closeErr
+		}
 		if err != nil {
 			return err
 		}
@@ -253,16 +294,13 @@ func (snapshotter *ExtensionSnapshotter) OnExportRetrieved(provider SwingStoreEx
 	// For debugging, write out any retrieved export data as a single untrusted artifact
 	// which has the same encoding as the internal SwingStore export data representation:
 	// a sequence of [key, value] JSON arrays each terminated by a new line.
-	exportDataArtifact := types.SwingStoreArtifact{Name: UntrustedExportDataArtifactName}
-
 	var encodedExportData bytes.Buffer
 	err = agoric.EncodeKVEntryReaderToJsonl(exportDataReader, &encodedExportData)
 	if err != nil {
 		return err
 	}
-	exportDataArtifact.Data = encodedExportData.Bytes()
 
-	err = writeArtifactToPayload(exportDataArtifact)
+	err = writeArtifactToPayload(UntrustedExportDataArtifactName, &encodedExportData)
 	encodedExportData.Reset()
 	if err != nil {
 		return err
@@ -274,7 +312,7 @@ func (snapshotter *ExtensionSnapshotter) OnExportRetrieved(provider SwingStoreEx
 // the payload reader returns io.EOF when it reaches the extension boundaries.
 // Implements ExtensionSnapshotter
 func (snapshotter *ExtensionSnapshotter) RestoreExtension(blockHeight uint64, format uint32, payloadReader snapshots.ExtensionPayloadReader) error {
-	if format != SnapshotFormat {
+	if format != SnapshotFormatArtifacts && format != SnapshotFormatArtifactChunks {
 		return snapshots.ErrUnknownFormat
 	}
 
@@ -293,6 +331,17 @@ func (snapshotter *ExtensionSnapshotter) RestoreExtension(blockHeight uint64, fo
 	}
 
 	readNextArtifact := func() (artifact types.SwingStoreArtifact, err error) {
+		if format == SnapshotFormatArtifactChunks {
+			artifactName, artifactReader, err := openArtifactChunks(payloadReader)
+			if err != nil {
+				return artifact, err
+			}
+			defer artifactReader.Close()
+			artifact.Name = artifactName
+			artifact.Data, err = io.ReadAll(artifactReader)
+			return artifact, err
+		}
+
 		payloadBytes, err := payloadReader()
 		if err != nil {
 			return artifact, err
@@ -302,8 +351,219 @@ func (snapshotter *ExtensionSnapshotter) RestoreExtension(blockHeight uint64, fo
 		return artifact, err
 	}
 
+	provider := SwingStoreExportProvider{BlockHeight: blockHeight, GetExportDataReader: getExportDataReader, ReadNextArtifact: readNextArtifact}
+	if format == SnapshotFormatArtifactChunks {
+		// Stream each artifact into the restore rather than joining its chunks.
+		provider.OpenNextArtifact = func() (string, io.ReadCloser, error) {
+			return openArtifactChunks(payloadReader)
+		}
+	}
+
 	return snapshotter.swingStoreExportsHandler.RestoreExport(
-		SwingStoreExportProvider{BlockHeight: blockHeight, GetExportDataReader: getExportDataReader, ReadNextArtifact: readNextArtifact},
+		provider,
 		SwingStoreRestoreOptions{ArtifactMode: SwingStoreArtifactModeOperational, ExportDataMode: SwingStoreExportDataModeAll},
 	)
 }
+
+// writeArtifactChunks writes the data of an artifact read from dataReader as
+// consecutive SwingStoreArtifactChunk payloads of at most chunkSize
+// uncompressed bytes, holding at most one chunk in memory.  An empty artifact
+// is written as a single empty chunk.
+func writeArtifactChunks(name string, dataReader io.Reader, chunkSize int, payloadWriter snapshots.ExtensionPayloadWriter) error {
+	reader := bufio.NewReader(dataReader)
+	buffer := make([]byte, chunkSize)
+	for index := uint64(0); ; index++ {
+		size, err := io.ReadFull(reader, buffer)
+		last := err == io.EOF || err == io.ErrUnexpectedEOF
+		if err == nil {
+			// A full chunk is the last one if no data follows it.
+			_, err = reader.Peek(1)
+			last = err == io.EOF
+		}
+		if err != nil && !last {
+			return err
+		}
+
+		chunk, err := newArtifactChunk(name, index, buffer[:size])
+		if err != nil {
+			return err
+		}
+		chunk.Last = last
+
+		payloadBytes, err := chunk.Marshal()
+		if err != nil {
+			return err
+		}
+		err = payloadWriter(payloadBytes)
+		if err != nil {
+			return err
+		}
+		if chunk.Last {
+			return nil
+		}
+	}
+}
+
+// newArtifactChunk returns a chunk of artifact data, gzip-compressed unless
+// that would not make it smaller.
+func newArtifactChunk(name string, index uint64, data []byte) (types.SwingStoreArtifactChunk, error) {
+	hash := sha256.Sum256(data)
+	chunk := types.SwingStoreArtifactChunk{
+		Name:   name,
+		Index:  index,
+		Size_:  uint64(len(data)),
+		Sha256: hash[:],
+		Data:   data,
+	}
+
+	var compressed bytes.Buffer
+	gzipWriter := gzip.NewWriter(&compressed)
+	_, err := gzipWriter.Write(data)
+	if err != nil {
+		return chunk, err
+	}
+	err = gzipWriter.Close()
+	if err != nil {
+		return chunk, err
+	}
+	if compressed.Len() < len(data) {
+		chunk.Compression = types.SwingStoreArtifactChunk_COMPRESSION_GZIP
+		chunk.Data = compressed.Bytes()
+	}
+	return chunk, nil
+}
+
+// artifactChunksReader reads the data of an artifact from its
+// SwingStoreArtifactChunk payloads, verifying each chunk as it is reached so
+// that at most one chunk is held in memory.
+type artifactChunksReader struct {
+	payloadReader snapshots.ExtensionPayloadReader
+	name          string
+	// nextIndex is the index of the next chunk to read.
+	nextIndex uint64
+	// data is the unread data of the current chunk.
+	data []byte
+	// last is whether the current chunk is the last one of the artifact.
+	last bool
+}
+
+// openArtifactChunks reads the first SwingStoreArtifactChunk payload of the
+// next artifact, returning the artifact name and a reader of its data.
+// Closing the reader skips any unread chunks of the artifact.
+func openArtifactChunks(payloadReader snapshots.ExtensionPayloadReader) (string, io.ReadCloser, error) {
+	payloadBytes, err := payloadReader()
+	if err != nil {
+		return "", nil, err
+	}
+	reader := &artifactChunksReader{payloadReader: payloadReader}
+	err = reader.addChunk(payloadBytes)
+	if err != nil {
+		return "", nil, err
+	}
+	return reader.name, reader, nil
+}
+
+// addChunk verifies the next chunk of the artifact and makes its data the
+// data to read.
+func (reader *artifactChunksReader) addChunk(payloadBytes []byte) error {
+	var chunk types.SwingStoreArtifactChunk
+	err := chunk.Unmarshal(payloadBytes)
+	if err != nil {
+		return err
+	}
+	index := reader.nextIndex
+	if index == 0 {
+		reader.name = chunk.Name
+	} else if chunk.Name != reader.name {
+		return fmt.Errorf("artifact %q chunk %d has unexpected name %q", reader.name, index, chunk.Name)
+	}
+	if chunk.Index != index {
+		return fmt.Errorf("artifact %q has chunk %d instead of %d", reader.name, chunk.Index, index)
+	}
+
+	data, err := decodeArtifactChunk(chunk)
+	if err != nil {
+		return fmt.Errorf("artifact %q chunk %d: %w", reader.name, index, err)
+	}
+	reader.nextIndex++
+	reader.data = data
+	reader.last = chunk.Last
+	return nil
+}
+
+// readChunk reads and verifies the next chunk of the artifact.
+func (reader *artifactChunksReader) readChunk() error {
+	payloadBytes, err := reader.payloadReader()
+	if err == io.EOF {
+		return fmt.Errorf("artifact %q is missing chunk %d: %w", reader.name, reader.nextIndex, io.ErrUnexpectedEOF)
+	} else if err != nil {
+		return err
+	}
+	return reader.addChunk(payloadBytes)
+}
+
+// Read reads the data of the artifact, reading its chunks as needed.
+// Implements io.Reader
+func (reader *artifactChunksReader) Read(p []byte) (int, error) {
+	for len(reader.data) == 0 {
+		if reader.last {
+			return 0, io.EOF
+		}
+		if err := reader.readChunk(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, reader.data)
+	reader.data = reader.data[n:]
+	return n, nil
+}
+
+// Close skips any unread chunks of the artifact, leaving the payload reader
+// at the start of the next artifact.
+// Implements io.Closer
+func (reader *artifactChunksReader) Close() error {
+	reader.data = nil
+	for !reader.last {
+		if err := reader.readChunk(); err != nil {
+			return err
+		}
+		reader.data = nil
+	}
+	return nil
+}
+
+// decodeArtifactChunk returns the uncompressed data of a chunk once verified
+// against its size and hash.
+func decodeArtifactChunk(chunk types.SwingStoreArtifactChunk) ([]byte, error) {
+	if chunk.Size_ > SnapshotArtifactChunkSize {
+		return nil, fmt.Errorf("size %d exceeds the maximum of %d", chunk.Size_, SnapshotArtifactChunkSize)
+	}
+
+	var data []byte
+	switch chunk.Compression {
+	case types.SwingStoreArtifactChunk_COMPRESSION_NONE:
+		data = chunk.Data
+	case types.SwingStoreArtifactChunk_COMPRESSION_GZIP:
+		gzipReader, err := gzip.NewReader(bytes.NewReader(chunk.Data))
+		if err != nil {
+			return nil, err
+		}
+		// Read at most one byte over the expected size to detect expansion
+		// beyond expectations.
+		data, err = io.ReadAll(io.LimitReader(gzipReader, int64(chunk.Size_)+1))
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unknown compression %s", chunk.Compression)
+	}
+
+	if uint64(len(data)) != chunk.Size_ {
+		return nil, fmt.Errorf("size %d does not match expected %d", len(data), chunk.Size_)
+	}
+	hash := sha256.Sum256(data)
+	if !bytes.Equal(hash[:], chunk.Sha256) {
+		return nil, errors.New("hash mismatch")
+	}
+	return data, nil
+}
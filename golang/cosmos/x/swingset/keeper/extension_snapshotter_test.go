@@ -1,9 +1,12 @@
 package keeper
 
 import (
+	"bytes"
+	"crypto/rand"
 	"io"
 	"testing"
 
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
 	"github.com/tendermint/tendermint/libs/log"
 )
 
@@ -13,6 +16,7 @@ func newTestExtensionSnapshotter() *ExtensionSnapshotter {
 		isConfigured:             func() bool { return true },
 		logger:                   logger,
 		swingStoreExportsHandler: newTestSwingStoreExportsHandler(),
+		snapshotFormat:           DefaultSnapshotFormat,
 	}
 }
 
@@ -37,7 +41,7 @@ func TestExtensionSnapshotterInProgress(t *testing.T) {
 	}
 
 	err = extensionSnapshotter.RestoreExtension(
-		456, SnapshotFormat,
+		456, DefaultSnapshotFormat,
 		func() ([]byte, error) {
 			return nil, io.EOF
 		})
@@ -61,6 +65,24 @@ func TestExtensionSnapshotterInProgress(t *testing.T) {
 	}
 }
 
+func TestExtensionSnapshotterSetSnapshotFormat(t *testing.T) {
+	extensionSnapshotter := newTestExtensionSnapshotter()
+	if format := extensionSnapshotter.SnapshotFormat(); format != SnapshotFormatArtifacts {
+		t.Errorf("got default snapshot format %d, want %d", format, SnapshotFormatArtifacts)
+	}
+	err := extensionSnapshotter.SetSnapshotFormat(SnapshotFormatArtifactChunks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if format := extensionSnapshotter.SnapshotFormat(); format != SnapshotFormatArtifactChunks {
+		t.Errorf("got snapshot format %d, want %d", format, SnapshotFormatArtifactChunks)
+	}
+	err = extensionSnapshotter.SetSnapshotFormat(3)
+	if err == nil {
+		t.Error("wanted error for unsupported snapshot format")
+	}
+}
+
 func TestExtensionSnapshotterNotConfigured(t *testing.T) {
 	extensionSnapshotter := newTestExtensionSnapshotter()
 	extensionSnapshotter.isConfigured = func() bool { return false }
@@ -102,3 +124,138 @@ func TestExtensionSnapshotterSecondCommit(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+// readArtifact reads the next artifact from its chunk payloads.
+func readArtifact(payloadReader func() ([]byte, error)) (types.SwingStoreArtifact, error) {
+	var artifact types.SwingStoreArtifact
+	name, artifactReader, err := openArtifactChunks(payloadReader)
+	if err != nil {
+		return artifact, err
+	}
+	defer artifactReader.Close()
+	artifact.Name = name
+	artifact.Data, err = io.ReadAll(artifactReader)
+	return artifact, err
+}
+
+func TestArtifactChunksRoundTrip(t *testing.T) {
+	random := make([]byte, 25)
+	if _, err := rand.Read(random); err != nil {
+		t.Fatal(err)
+	}
+	artifacts := []types.SwingStoreArtifact{
+		{Name: "empty"},
+		{Name: "compressible", Data: bytes.Repeat([]byte("transcript "), 10)},
+		{Name: "incompressible", Data: random},
+		{Name: "exact", Data: []byte("0123456789")},
+		{Name: "exact multiple", Data: []byte("0123456789abcdefghij")},
+	}
+
+	var payloads [][]byte
+	payloadWriter := func(payload []byte) error {
+		payloads = append(payloads, payload)
+		return nil
+	}
+	for _, artifact := range artifacts {
+		if err := writeArtifactChunks(artifact.Name, bytes.NewReader(artifact.Data), 10, payloadWriter); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// An artifact whose size is a multiple of the chunk size has no trailing
+	// empty chunk.
+	if len(payloads) != 1+11+3+1+2 {
+		t.Errorf("got %d payloads", len(payloads))
+	}
+	var lastChunk types.SwingStoreArtifactChunk
+	if err := lastChunk.Unmarshal(payloads[len(payloads)-1]); err != nil {
+		t.Fatal(err)
+	}
+	if lastChunk.Index != 1 || !lastChunk.Last || string(lastChunk.Data) != "abcdefghij" {
+		t.Errorf("got last chunk %d %q, last %v", lastChunk.Index, lastChunk.Data, lastChunk.Last)
+	}
+	var chunk types.SwingStoreArtifactChunk
+	if err := chunk.Unmarshal(payloads[1]); err != nil {
+		t.Fatal(err)
+	}
+	if chunk.Compression != types.SwingStoreArtifactChunk_COMPRESSION_NONE {
+		// Too small to benefit from compression.
+		t.Errorf("got compression %s", chunk.Compression)
+	}
+
+	newPayloadReader := func(payloads [][]byte) func() ([]byte, error) {
+		return func() ([]byte, error) {
+			if len(payloads) == 0 {
+				return nil, io.EOF
+			}
+			payload := payloads[0]
+			payloads = payloads[1:]
+			return payload, nil
+		}
+	}
+	payloadReader := newPayloadReader(payloads)
+	for _, want := range artifacts {
+		got, err := readArtifact(payloadReader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.Name != want.Name || !bytes.Equal(got.Data, want.Data) {
+			t.Errorf("got artifact %q %q, want %q %q", got.Name, got.Data, want.Name, want.Data)
+		}
+	}
+	if _, err := readArtifact(payloadReader); err != io.EOF {
+		t.Errorf("got %v, want EOF", err)
+	}
+
+	// Closing a partially read artifact skips its remaining chunks.
+	payloadReader = newPayloadReader(payloads[1:])
+	name, artifactReader, err := openArtifactChunks(payloadReader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := artifactReader.Read(make([]byte, 5)); err != nil {
+		t.Fatal(err)
+	}
+	if err := artifactReader.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := readArtifact(payloadReader); name != "compressible" || err != nil || got.Name != "incompressible" {
+		t.Errorf("got artifact %q after closing %q, err %v", got.Name, name, err)
+	}
+
+	// A truncated artifact is an error.
+	if _, err := readArtifact(newPayloadReader(payloads[1:3])); err == nil || err == io.EOF {
+		t.Errorf("got %v for truncated artifact", err)
+	}
+
+	// A tampered chunk is an error.
+	chunk.Data = bytes.ToUpper(chunk.Data)
+	tampered, err := chunk.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := readArtifact(newPayloadReader([][]byte{tampered})); err == nil {
+		t.Error("wanted error for tampered chunk")
+	}
+}
+
+func TestLargeArtifactChunkCompression(t *testing.T) {
+	data := bytes.Repeat([]byte("heap snapshot "), 1000)
+	var payloads [][]byte
+	err := writeArtifactChunks("large", bytes.NewReader(data), SnapshotArtifactChunkSize, func(payload []byte) error {
+		payloads = append(payloads, payload)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(payloads) != 1 {
+		t.Fatalf("got %d payloads", len(payloads))
+	}
+	var chunk types.SwingStoreArtifactChunk
+	if err := chunk.Unmarshal(payloads[0]); err != nil {
+		t.Fatal(err)
+	}
+	if chunk.Compression != types.SwingStoreArtifactChunk_COMPRESSION_GZIP || len(chunk.Data) >= len(data) || !chunk.Last {
+		t.Errorf("got compression %s, %d bytes, last %v", chunk.Compression, len(chunk.Data), chunk.Last)
+	}
+}
@@ -61,3 +61,227 @@ func (k Querier) Mailbox(c context.Context, req *types.QueryMailboxRequest) (*ty
 		Value: value,
 	}, nil
 }
+
+func (k Querier) Egresses(c context.Context, req *types.QueryEgressesRequest) (*types.QueryEgressesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	egresses := []types.Egress{}
+	pageRes, err := k.PaginateEgresses(ctx, req.PowerFlag, req.Pagination, func(egress types.Egress) error {
+		egresses = append(egresses, egress)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryEgressesResponse{
+		Egresses:   egresses,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Querier) Mailboxes(c context.Context, req *types.QueryMailboxesRequest) (*types.QueryMailboxesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	mailboxes := []types.PeerMailbox{}
+	pageRes, err := k.PaginateMailboxes(ctx, req.PowerFlag, req.Pagination, func(peer string, mailbox string) error {
+		mailboxes = append(mailboxes, types.PeerMailbox{Peer: peer, Value: mailbox})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryMailboxesResponse{
+		Mailboxes:  mailboxes,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Querier) BeansOwing(c context.Context, req *types.QueryBeansOwingRequest) (*types.QueryBeansOwingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := sdk.VerifyAddressFormat(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryBeansOwingResponse{
+		Beans: k.GetBeansOwing(ctx, req.Address),
+	}, nil
+}
+
+func (k Querier) InboundPrice(c context.Context, req *types.QueryInboundPriceRequest) (*types.QueryInboundPriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	beans, fee, enabled := k.GetInboundPrice(ctx)
+	return &types.QueryInboundPriceResponse{
+		Enabled: enabled,
+		Beans:   beans,
+		Fee:     fee,
+	}, nil
+}
+
+func (k Querier) ChargeHistory(c context.Context, req *types.QueryChargeHistoryRequest) (*types.QueryChargeHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := sdk.VerifyAddressFormat(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	charges := []types.ChargeRecord{}
+	pageRes, err := k.PaginateChargeHistory(ctx, req.Address, req.Pagination, func(record types.ChargeRecord) error {
+		charges = append(charges, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryChargeHistoryResponse{
+		Charges:    charges,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Querier) InboundQueue(c context.Context, req *types.QueryInboundQueueRequest) (*types.QueryInboundQueueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	queuePath := StoragePathActionQueue
+	if req.HighPriority {
+		queuePath = StoragePathHighPriorityQueue
+	}
+	entries := []types.InboundQueueEntry{}
+	pageRes, err := k.PaginateInboundQueue(ctx, queuePath, req.Pagination, func(entry types.InboundQueueEntry) error {
+		entries = append(entries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	queueLength, err := k.vstorageKeeper.GetQueueLength(ctx, queuePath)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	inboundQueueLength, err := k.InboundQueueLength(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryInboundQueueResponse{
+		Entries:            entries,
+		QueueLength:        queueLength.String(),
+		InboundQueueLength: inboundQueueLength,
+		QueueAllowed:       k.GetState(ctx).QueueAllowed,
+		QueueMax:           k.GetParams(ctx).QueueMax,
+		Pagination:         pageRes,
+	}, nil
+}
+
+func (k Querier) ScheduledAction(c context.Context, req *types.QueryScheduledActionRequest) (*types.QueryScheduledActionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	scheduledAction, found := k.GetScheduledAction(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "scheduled action %d not found", req.Id)
+	}
+
+	return &types.QueryScheduledActionResponse{
+		ScheduledAction: scheduledAction,
+	}, nil
+}
+
+func (k Querier) ScheduledActions(c context.Context, req *types.QueryScheduledActionsRequest) (*types.QueryScheduledActionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := sdk.VerifyAddressFormat(req.Owner); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	scheduledActions := []types.ScheduledAction{}
+	pageRes, err := k.PaginateScheduledActions(ctx, req.Owner, req.Pagination, func(sa types.ScheduledAction) error {
+		scheduledActions = append(scheduledActions, sa)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryScheduledActionsResponse{
+		ScheduledActions: scheduledActions,
+		Pagination:       pageRes,
+	}, nil
+}
+
+func (k Querier) Bundle(c context.Context, req *types.QueryBundleRequest) (*types.QueryBundleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	bundle, found := k.GetInstalledBundle(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "bundle %s not found", req.Id)
+	}
+
+	return &types.QueryBundleResponse{
+		Bundle: bundle,
+	}, nil
+}
+
+func (k Querier) Bundles(c context.Context, req *types.QueryBundlesRequest) (*types.QueryBundlesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	bundles := []types.InstalledBundle{}
+	pageRes, err := k.PaginateInstalledBundles(ctx, req.Pagination, func(bundle types.InstalledBundle) error {
+		bundles = append(bundles, bundle)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryBundlesResponse{
+		Bundles:    bundles,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Querier) BundleUpload(c context.Context, req *types.QueryBundleUploadRequest) (*types.QueryBundleUploadResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	upload, found := k.GetBundleUpload(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "bundle upload %d not found", req.Id)
+	}
+
+	return &types.QueryBundleUploadResponse{
+		Upload: upload,
+	}, nil
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	stdlog "log"
 	"math"
+	"strings"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -16,7 +17,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/Agoric/agoric-sdk/golang/cosmos/ante"
@@ -54,6 +58,7 @@ const (
 // Keeper maintains the link to data vstorage and exposes getter/setter methods for the various parts of the state machine
 type Keeper struct {
 	storeKey   storetypes.StoreKey
+	tStoreKey  storetypes.StoreKey
 	cdc        codec.Codec
 	paramSpace paramtypes.Subspace
 
@@ -71,7 +76,7 @@ var _ ante.SwingsetKeeper = &Keeper{}
 
 // NewKeeper creates a new IBC transfer Keeper instance
 func NewKeeper(
-	cdc codec.Codec, key storetypes.StoreKey, paramSpace paramtypes.Subspace,
+	cdc codec.Codec, key storetypes.StoreKey, tkey storetypes.StoreKey, paramSpace paramtypes.Subspace,
 	accountKeeper types.AccountKeeper, bankKeeper bankkeeper.Keeper,
 	vstorageKeeper vstoragekeeper.Keeper, feeCollectorName string,
 	callToController func(ctx sdk.Context, str string) (string, error),
@@ -84,6 +89,7 @@ func NewKeeper(
 
 	return Keeper{
 		storeKey:         key,
+		tStoreKey:        tkey,
 		cdc:              cdc,
 		paramSpace:       paramSpace,
 		accountKeeper:    accountKeeper,
@@ -224,6 +230,12 @@ func (k Keeper) UpdateQueueAllowed(ctx sdk.Context) error {
 		{Key: types.QueueInbound, Size_: inboundQueueAllowed},
 		{Key: types.QueueInboundMempool, Size_: inboundMempoolQueueAllowed},
 	}
+	// Per-block admission budgets apply as configured.
+	for _, qs := range params.QueueMax {
+		if qs.Key == types.QueueInboundSender || strings.HasPrefix(qs.Key, types.QueueInboundMsgTypePrefix) {
+			state.QueueAllowed = append(state.QueueAllowed, qs)
+		}
+	}
 	k.SetState(ctx, state)
 
 	return nil
@@ -254,6 +266,12 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSpace.SetParamSet(ctx, &params)
 }
 
+// GetAuthority returns the address of the governance module, which alone may
+// update params and schedule core evals.
+func (k Keeper) GetAuthority() sdk.AccAddress {
+	return authtypes.NewModuleAddress(govtypes.ModuleName)
+}
+
 func (k Keeper) GetState(ctx sdk.Context) types.State {
 	store := ctx.KVStore(k.storeKey)
 	bz := store.Get([]byte(stateKey))
@@ -303,8 +321,9 @@ func (k Keeper) SetBeansOwing(ctx sdk.Context, addr sdk.AccAddress, beans sdkmat
 
 // ChargeBeans charges the given address the given number of beans.  It divides
 // the beans into the number to debit immediately vs. the number to store in the
-// beansOwing.
-func (k Keeper) ChargeBeans(ctx sdk.Context, addr sdk.AccAddress, beans sdkmath.Uint) error {
+// beansOwing, and records the charge of the given type in the address's charge
+// history.
+func (k Keeper) ChargeBeans(ctx sdk.Context, addr sdk.AccAddress, beans sdkmath.Uint, chargeType string) error {
 	beansPerUnit := k.GetBeansPerUnit(ctx)
 
 	wasOwing := k.GetBeansOwing(ctx, addr)
@@ -335,6 +354,12 @@ func (k Keeper) ChargeBeans(ctx sdk.Context, addr sdk.AccAddress, beans sdkmath.
 	// Record the new owing value, whether we have debited immediately or not
 	// (i.e. there is more owing than before, but not enough to debit).
 	k.SetBeansOwing(ctx, addr, remainderOwing)
+	k.recordCharge(ctx, addr, types.ChargeRecord{
+		ChargeType: chargeType,
+		Beans:      beans,
+		Debited:    feeCoins,
+		BeansOwing: remainderOwing,
+	})
 	return nil
 }
 
@@ -342,7 +367,7 @@ func (k Keeper) ChargeBeans(ctx sdk.Context, addr sdk.AccAddress, beans sdkmath.
 func (k Keeper) ChargeForSmartWallet(ctx sdk.Context, addr sdk.AccAddress) error {
 	beansPerUnit := k.GetBeansPerUnit(ctx)
 	beans := beansPerUnit[types.BeansPerSmartWalletProvision]
-	err := k.ChargeBeans(ctx, addr, beans)
+	err := k.ChargeBeans(ctx, addr, beans, types.ChargeTypeSmartWalletProvision)
 	if err != nil {
 		return err
 	}
@@ -414,7 +439,17 @@ func (k Keeper) ChargeForProvisioning(ctx sdk.Context, submitter, addr sdk.AccAd
 	if fees.IsZero() {
 		return nil
 	}
-	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, submitter, k.feeCollectorName, fees)
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, submitter, k.feeCollectorName, fees)
+	if err != nil {
+		return err
+	}
+	k.recordCharge(ctx, submitter, types.ChargeRecord{
+		ChargeType: types.ChargeTypeProvisionPowerFlags,
+		Beans:      sdkmath.ZeroUint(),
+		Debited:    fees,
+		BeansOwing: k.GetBeansOwing(ctx, submitter),
+	})
+	return nil
 }
 
 // GetEgress gets the entire egress struct for a peer
@@ -473,6 +508,74 @@ func (k Keeper) GetMailbox(ctx sdk.Context, peer string) string {
 	return k.vstorageKeeper.GetEntry(ctx, path).StringValue()
 }
 
+// hasPowerFlag tells if an egress has a power flag.
+func hasPowerFlag(egress types.Egress, powerFlag string) bool {
+	for _, flag := range egress.PowerFlags {
+		if flag == powerFlag {
+			return true
+		}
+	}
+	return false
+}
+
+// PaginateEgresses calls onResult with each provisioned egress in order of
+// peer address, subject to pageRequest.  If powerFlag is not empty, only
+// egresses with that power flag are included.
+func (k Keeper) PaginateEgresses(
+	ctx sdk.Context,
+	powerFlag string,
+	pageRequest *query.PageRequest,
+	onResult func(egress types.Egress) error,
+) (*query.PageResponse, error) {
+	return k.vstorageKeeper.FilteredPaginateChildren(ctx, StoragePathEgress, pageRequest, func(entry agoric.KVEntry, accumulate bool) (bool, error) {
+		if !entry.HasValue() {
+			return false, nil
+		}
+		var egress types.Egress
+		if err := json.Unmarshal([]byte(entry.StringValue()), &egress); err != nil {
+			return false, fmt.Errorf("invalid egress %s: %w", entry.Key(), err)
+		}
+		if powerFlag != "" && !hasPowerFlag(egress, powerFlag) {
+			return false, nil
+		}
+		if accumulate {
+			if err := onResult(egress); err != nil {
+				return false, err
+			}
+		}
+		return true, nil
+	})
+}
+
+// PaginateMailboxes calls onResult with each peer and its outbound mailbox in
+// order of peer, subject to pageRequest.  If powerFlag is not empty, only the
+// mailboxes of peers whose egress has that power flag are included.
+func (k Keeper) PaginateMailboxes(
+	ctx sdk.Context,
+	powerFlag string,
+	pageRequest *query.PageRequest,
+	onResult func(peer string, mailbox string) error,
+) (*query.PageResponse, error) {
+	return k.vstorageKeeper.FilteredPaginateChildren(ctx, StoragePathMailbox, pageRequest, func(entry agoric.KVEntry, accumulate bool) (bool, error) {
+		if !entry.HasValue() {
+			return false, nil
+		}
+		peer := entry.Key()
+		if powerFlag != "" {
+			addr, err := sdk.AccAddressFromBech32(peer)
+			if err != nil || !hasPowerFlag(k.GetEgress(ctx, addr), powerFlag) {
+				return false, nil
+			}
+		}
+		if accumulate {
+			if err := onResult(peer, entry.StringValue()); err != nil {
+				return false, err
+			}
+		}
+		return true, nil
+	})
+}
+
 // SetMailbox sets the entire mailbox struct for a peer
 func (k Keeper) SetMailbox(ctx sdk.Context, peer string, mailbox string) {
 	path := StoragePathMailbox + "." + peer
@@ -1,15 +1,32 @@
 package keeper
 
 import (
+	"bytes"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
+	sdkmath "cosmossdk.io/math"
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+	vstoragekeeper "github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/keeper"
+	vstoragetypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/store"
 	prefixstore "github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	dbm "github.com/tendermint/tm-db"
 )
@@ -258,3 +275,765 @@ func TestSwingStore(t *testing.T) {
 		t.Errorf("got export %q, want %q", gotEntries, expectedEntries)
 	}
 }
+
+func TestChargeHistory(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(swingsetStoreKey, storetypes.StoreTypeIAVL, db)
+	if err := ms.LoadLatestVersion(); err != nil {
+		t.Fatal(err)
+	}
+	ctx := sdk.NewContext(ms, tmproto.Header{}, false, log.NewNopLogger())
+	k := Keeper{
+		storeKey: swingsetStoreKey,
+		cdc:      codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
+	}
+
+	// Overflow the history of one address, and charge another once.
+	for i := uint64(1); i <= MaxChargeHistoryPerAddress+2; i++ {
+		k.recordCharge(ctx.WithBlockHeight(int64(i)), submitAddr, types.ChargeRecord{
+			ChargeType: types.ChargeTypeInboundAdmission,
+			Beans:      sdkmath.NewUint(i),
+			Debited:    cns(a(int64(i))),
+			BeansOwing: sdkmath.ZeroUint(),
+		})
+	}
+	k.recordCharge(ctx.WithBlockHeight(7), utilAddr, types.ChargeRecord{
+		ChargeType: types.ChargeTypeProvisionPowerFlags,
+		Beans:      sdkmath.ZeroUint(),
+		Debited:    cns(b(10)),
+		BeansOwing: sdkmath.NewUint(5),
+	})
+
+	collect := func(addr sdk.AccAddress, pageReq *query.PageRequest) []types.ChargeRecord {
+		records := []types.ChargeRecord{}
+		_, err := k.PaginateChargeHistory(ctx, addr, pageReq, func(record types.ChargeRecord) error {
+			records = append(records, record)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("PaginateChargeHistory: %v", err)
+		}
+		return records
+	}
+
+	records := collect(submitAddr, &query.PageRequest{Limit: MaxChargeHistoryPerAddress + 10})
+	if len(records) != MaxChargeHistoryPerAddress {
+		t.Fatalf("got %d records, want %d", len(records), MaxChargeHistoryPerAddress)
+	}
+	if got := records[0].BlockHeight; got != 3 {
+		t.Errorf("oldest record: got block height %d, want 3", got)
+	}
+	if got := records[len(records)-1]; got.BlockHeight != MaxChargeHistoryPerAddress+2 || !got.Beans.Equal(sdkmath.NewUint(MaxChargeHistoryPerAddress+2)) {
+		t.Errorf("newest record: got %+v", got)
+	}
+
+	records = collect(utilAddr, nil)
+	expected := []types.ChargeRecord{{
+		BlockHeight: 7,
+		TxHash:      "unknown",
+		ChargeType:  types.ChargeTypeProvisionPowerFlags,
+		Beans:       sdkmath.ZeroUint(),
+		Debited:     cns(b(10)),
+		BeansOwing:  sdkmath.NewUint(5),
+	}}
+	if !reflect.DeepEqual(records, expected) {
+		t.Errorf("got %+v, want %+v", records, expected)
+	}
+
+	// The histories survive a genesis export and import.
+	histories := k.GetAllChargeHistory(ctx)
+	if len(histories) != 2 {
+		t.Fatalf("got %d exported histories, want 2", len(histories))
+	}
+	importedStore := store.NewCommitMultiStore(dbm.NewMemDB())
+	importedStore.MountStoreWithDB(swingsetStoreKey, storetypes.StoreTypeIAVL, nil)
+	if err := importedStore.LoadLatestVersion(); err != nil {
+		t.Fatal(err)
+	}
+	importedCtx := sdk.NewContext(importedStore, tmproto.Header{}, false, log.NewNopLogger())
+	k.SetChargeHistory(importedCtx, histories)
+	if got := k.GetAllChargeHistory(importedCtx); !reflect.DeepEqual(got, histories) {
+		t.Errorf("got imported histories %+v, want %+v", got, histories)
+	}
+
+	// Pruning forgets the charges made up to block 7, including the whole
+	// history of utilAddr.
+	k.PruneChargeHistory(ctx.WithBlockHeight(ChargeHistoryRetentionBlocks + 7))
+	records = collect(submitAddr, &query.PageRequest{Limit: MaxChargeHistoryPerAddress})
+	if len(records) != MaxChargeHistoryPerAddress-5 || records[0].BlockHeight != 8 {
+		t.Errorf("got %d records from block %d after pruning", len(records), records[0].BlockHeight)
+	}
+	histories = k.GetAllChargeHistory(ctx)
+	if len(histories) != 1 || !histories[0].Address.Equals(submitAddr) {
+		t.Errorf("got histories %+v after pruning", histories)
+	}
+	expiryIterator := sdk.KVStorePrefixIterator(ctx.KVStore(swingsetStoreKey), []byte(chargeHistoryExpiryKeyPrefix))
+	defer expiryIterator.Close()
+	expiryEntries := 0
+	for ; expiryIterator.Valid(); expiryIterator.Next() {
+		expiryEntries++
+	}
+	if expiryEntries != len(records) {
+		t.Errorf("got %d charge expiry entries, want %d", expiryEntries, len(records))
+	}
+}
+
+func TestScheduledActions(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(swingsetStoreKey, storetypes.StoreTypeIAVL, db)
+	if err := ms.LoadLatestVersion(); err != nil {
+		t.Fatal(err)
+	}
+	ctx := sdk.NewContext(ms, tmproto.Header{Height: 10, Time: time.Unix(1000, 0)}, false, log.NewNopLogger())
+	k := Keeper{
+		storeKey: swingsetStoreKey,
+		cdc:      codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
+	}
+
+	schedule := []types.ScheduledAction{
+		{Owner: submitAddr, Action: "1", NotBeforeHeight: 12},
+		{Owner: utilAddr, Action: "2", NotBeforeTime: 1010},
+		{Owner: submitAddr, Action: "3", NotBeforeHeight: 11},
+		{Owner: submitAddr, Action: "4", NotBeforeTime: 1005},
+	}
+	for i, sa := range schedule {
+		id, err := k.AddScheduledAction(ctx, sa)
+		if err != nil {
+			t.Fatalf("AddScheduledAction: %v", err)
+		}
+		if id != uint64(i) {
+			t.Errorf("got id %d, want %d", id, i)
+		}
+	}
+
+	if sa, found := k.GetScheduledAction(ctx, 2); !found || sa.Action != "3" || sa.ScheduledHeight != 10 {
+		t.Errorf("got scheduled action %+v, %v", sa, found)
+	}
+
+	var actions []string
+	_, err := k.PaginateScheduledActions(ctx, submitAddr, nil, func(sa types.ScheduledAction) error {
+		actions = append(actions, sa.Action)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("PaginateScheduledActions: %v", err)
+	}
+	if !reflect.DeepEqual(actions, []string{"1", "3", "4"}) {
+		t.Errorf("got actions %q of owner", actions)
+	}
+
+	dueAt := func(height, unixTime int64) []uint64 {
+		dueCtx := ctx.WithBlockHeight(height).WithBlockTime(time.Unix(unixTime, 0))
+		keys := k.dueScheduledActionKeys(dueCtx, scheduledByHeightKeyPrefix, height, MaxScheduledActionsPerBlock)
+		keys = append(keys, k.dueScheduledActionKeys(dueCtx, scheduledByTimeKeyPrefix, unixTime, MaxScheduledActionsPerBlock)...)
+		ids := []uint64{}
+		for _, key := range keys {
+			ids = append(ids, scheduleIndexID(key))
+		}
+		return ids
+	}
+	if got := dueAt(10, 1000); len(got) != 0 {
+		t.Errorf("got due %v, want none", got)
+	}
+	if got := dueAt(11, 1005); !reflect.DeepEqual(got, []uint64{2, 3}) {
+		t.Errorf("got due %v, want [2 3]", got)
+	}
+	if got := dueAt(20, 2000); !reflect.DeepEqual(got, []uint64{2, 0, 3, 1}) {
+		t.Errorf("got due %v, want [2 0 3 1]", got)
+	}
+
+	if err := k.RemoveScheduledAction(ctx, utilAddr, 2); err == nil {
+		t.Errorf("removed scheduled action of another owner")
+	}
+	if err := k.RemoveScheduledAction(ctx, submitAddr, 2); err != nil {
+		t.Fatalf("RemoveScheduledAction: %v", err)
+	}
+	if _, found := k.GetScheduledAction(ctx, 2); found {
+		t.Errorf("removed scheduled action was found")
+	}
+	if got := dueAt(20, 2000); !reflect.DeepEqual(got, []uint64{0, 3, 1}) {
+		t.Errorf("got due %v, want [0 3 1]", got)
+	}
+
+	// Genesis import must preserve the sequence.
+	exported := k.GetAllScheduledActions(ctx)
+	if len(exported) != 3 {
+		t.Fatalf("got %d exported scheduled actions, want 3", len(exported))
+	}
+	k.SetScheduledActions(ctx, exported)
+	if id, _ := k.AddScheduledAction(ctx, types.ScheduledAction{Owner: utilAddr, Action: "5", NotBeforeHeight: 30}); id != 4 {
+		t.Errorf("got id %d, want 4", id)
+	}
+}
+
+// failingBankKeeper fails every payment to a module and records refunds to
+// accounts.
+type failingBankKeeper struct {
+	bankkeeper.Keeper
+	refunds map[string]sdk.Coins
+}
+
+func (bk failingBankKeeper) SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	return fmt.Errorf("cannot pay %s to %s", amt, recipientModule)
+}
+
+func (bk failingBankKeeper) SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	bk.refunds[recipientAddr.String()] = bk.refunds[recipientAddr.String()].Add(amt...)
+	return nil
+}
+
+func TestDeliverScheduledActions(t *testing.T) {
+	tStoreKey := storetypes.NewTransientStoreKey(types.TStoreKey)
+	vstorageStoreKey := storetypes.NewKVStoreKey(vstoragetypes.StoreKey)
+	vstorageMetaStoreKey := storetypes.NewKVStoreKey(vstoragetypes.MetaStoreKey)
+	paramsStoreKey := storetypes.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTStoreKey := storetypes.NewTransientStoreKey(paramstypes.TStoreKey)
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(swingsetStoreKey, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tStoreKey, storetypes.StoreTypeTransient, db)
+	ms.MountStoreWithDB(vstorageStoreKey, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(vstorageMetaStoreKey, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsStoreKey, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsTStoreKey, storetypes.StoreTypeTransient, db)
+	if err := ms.LoadLatestVersion(); err != nil {
+		t.Fatal(err)
+	}
+	ctx := sdk.NewContext(ms, tmproto.Header{Height: 10}, false, log.NewNopLogger())
+	pk := paramskeeper.NewKeeper(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), codec.NewLegacyAmino(), paramsStoreKey, paramsTStoreKey)
+	bankKeeper := failingBankKeeper{refunds: map[string]sdk.Coins{}}
+	k := Keeper{
+		storeKey:         swingsetStoreKey,
+		tStoreKey:        tStoreKey,
+		cdc:              codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
+		bankKeeper:       bankKeeper,
+		vstorageKeeper:   vstoragekeeper.NewKeeper(vstorageStoreKey, vstorageMetaStoreKey, pk.Subspace(vstoragetypes.ModuleName)),
+		feeCollectorName: "fee_collector",
+	}
+	otherAddr := sdk.AccAddress([]byte("other"))
+	k.SetState(ctx, types.State{QueueAllowed: []types.QueueSize{
+		types.NewQueueSize(types.QueueInbound, 2),
+		types.NewQueueSize(types.QueueInboundSender, 1),
+	}})
+
+	// Genesis scheduled actions have their escrow in the module account.
+	k.SetScheduledActions(ctx, []types.ScheduledAction{
+		// Cannot pay its escrow, so is dropped.
+		{Id: 0, Owner: submitAddr, Action: "0", NotBeforeHeight: 5, Escrow: cns(a(5))},
+		{Id: 1, Owner: submitAddr, Action: "1", NotBeforeHeight: 5},
+		// Exceeds the sender budget.
+		{Id: 2, Owner: submitAddr, Action: "2", NotBeforeHeight: 5},
+		{Id: 3, Owner: utilAddr, Action: "3", NotBeforeHeight: 5},
+		// Exceeds the inbound queue allowance.
+		{Id: 4, Owner: otherAddr, Action: "4", NotBeforeHeight: 5},
+	})
+	if err := k.DeliverScheduledActions(ctx); err != nil {
+		t.Fatalf("DeliverScheduledActions: %v", err)
+	}
+
+	var remaining []uint64
+	for _, sa := range k.GetAllScheduledActions(ctx) {
+		remaining = append(remaining, sa.Id)
+	}
+	if !reflect.DeepEqual(remaining, []uint64{2, 4}) {
+		t.Errorf("got remaining scheduled actions %v, want [2 4]", remaining)
+	}
+	if !reflect.DeepEqual(bankKeeper.refunds, map[string]sdk.Coins{submitAddr.String(): cns(a(5))}) {
+		t.Errorf("got refunds %v", bankKeeper.refunds)
+	}
+	if length, err := k.InboundQueueLength(ctx); err != nil || length != 2 {
+		t.Errorf("got inbound queue length %d, %v; want 2", length, err)
+	}
+	if got := k.GetInboundAdmissions(ctx, types.QueueInboundSender+":"+submitAddr.String()); got != 1 {
+		t.Errorf("got %d sender admissions, want 1", got)
+	}
+
+	// A backlog of actions beyond an owner's budget does not hold back those of
+	// other owners, and the index entry of a missing action is removed.
+	for _, sa := range k.GetAllScheduledActions(ctx) {
+		if err := k.RemoveScheduledAction(ctx, sa.Owner, sa.Id); err != nil {
+			t.Fatal(err)
+		}
+	}
+	ctx = ctx.WithBlockHeight(11)
+	backlogAddr := sdk.AccAddress([]byte("backlog"))
+	laterAddr := sdk.AccAddress([]byte("later"))
+	k.SetState(ctx, types.State{QueueAllowed: []types.QueueSize{
+		types.NewQueueSize(types.QueueInbound, 1000),
+		types.NewQueueSize(types.QueueInboundSender, 1),
+	}})
+	backlog := []types.ScheduledAction{}
+	for id := uint64(10); id < 10+2*MaxScheduledActionsPerBlock; id++ {
+		backlog = append(backlog, types.ScheduledAction{Id: id, Owner: backlogAddr, Action: "backlog", NotBeforeHeight: 6})
+	}
+	backlog = append(backlog, types.ScheduledAction{Id: 1000, Owner: laterAddr, Action: "other", NotBeforeHeight: 7})
+	k.SetScheduledActions(ctx, backlog)
+	danglingKey := scheduleIndexKey(types.ScheduledAction{Id: 999, NotBeforeHeight: 6})
+	ctx.KVStore(swingsetStoreKey).Set(danglingKey, []byte{})
+	if err := k.DeliverScheduledActions(ctx); err != nil {
+		t.Fatalf("DeliverScheduledActions: %v", err)
+	}
+	if _, found := k.GetScheduledAction(ctx, 1000); found {
+		t.Errorf("scheduled action of other owner was not delivered")
+	}
+	if _, found := k.GetScheduledAction(ctx, 10); found {
+		t.Errorf("first backlog action was not delivered")
+	}
+	if _, found := k.GetScheduledAction(ctx, 11); !found {
+		t.Errorf("backlog action beyond the sender budget was delivered")
+	}
+	if ctx.KVStore(swingsetStoreKey).Has(danglingKey) {
+		t.Errorf("index entry of missing scheduled action was not removed")
+	}
+}
+
+func TestInstalledBundles(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(swingsetStoreKey, storetypes.StoreTypeIAVL, db)
+	if err := ms.LoadLatestVersion(); err != nil {
+		t.Fatal(err)
+	}
+	ctx := sdk.NewContext(ms, tmproto.Header{Height: 10}, false, log.NewNopLogger())
+	k := Keeper{
+		storeKey: swingsetStoreKey,
+		cdc:      codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
+	}
+
+	k.RecordInstalledBundle(ctx, "b1-bbb", submitAddr, 200)
+	k.RecordInstalledBundle(ctx, "b1-aaa", utilAddr, 100)
+	// A resubmission does not replace the original record.
+	k.RecordInstalledBundle(ctx.WithBlockHeight(11), "b1-bbb", utilAddr, 300)
+
+	bundle, found := k.GetInstalledBundle(ctx, "b1-bbb")
+	if !found {
+		t.Fatal("b1-bbb not found")
+	}
+	want := types.InstalledBundle{Id: "b1-bbb", Submitter: submitAddr, Size_: 200, BlockHeight: 10}
+	if !reflect.DeepEqual(bundle, want) {
+		t.Errorf("got %v, want %v", bundle, want)
+	}
+	if _, found := k.GetInstalledBundle(ctx, "b1-ccc"); found {
+		t.Error("b1-ccc unexpectedly found")
+	}
+
+	ids := []string{}
+	pageRes, err := k.PaginateInstalledBundles(ctx, &query.PageRequest{Limit: 1, CountTotal: true}, func(bundle types.InstalledBundle) error {
+		ids = append(ids, bundle.Id)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(ids, []string{"b1-aaa"}) || pageRes.Total != 2 || len(pageRes.NextKey) == 0 {
+		t.Errorf("got %v, %v", ids, pageRes)
+	}
+
+	all := k.GetAllInstalledBundles(ctx)
+	if len(all) != 2 || all[0].Id != "b1-aaa" || all[1].Id != "b1-bbb" {
+		t.Errorf("got %v", all)
+	}
+}
+
+func TestBundleUpload(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(swingsetStoreKey, storetypes.StoreTypeIAVL, db)
+	if err := ms.LoadLatestVersion(); err != nil {
+		t.Fatal(err)
+	}
+	ctx := sdk.NewContext(ms, tmproto.Header{Height: 10}, false, log.NewNopLogger())
+	k := Keeper{
+		storeKey: swingsetStoreKey,
+		cdc:      codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
+	}
+
+	data := []byte(`{"moduleFormat":"endoZipBase64"}`)
+	digest := sha512.Sum512(data)
+	hash := hex.EncodeToString(digest[:])
+	begin := func(ctx sdk.Context, submitter sdk.AccAddress) uint64 {
+		t.Helper()
+		id, err := k.AddBundleUpload(ctx, submitter, hash, int64(len(data)), 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return id
+	}
+	openUploads := func(ctx sdk.Context, submitter sdk.AccAddress) int {
+		t.Helper()
+		uploads, err := k.submitterBundleUploads(ctx, submitter)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return len(uploads)
+	}
+
+	id := begin(ctx, submitAddr)
+	if _, err := k.CompleteBundleUpload(ctx, submitAddr, id); err == nil {
+		t.Error("completed an upload without chunks")
+	}
+	if err := k.AddBundleChunk(ctx, utilAddr, id, 0, data[:10]); err == nil {
+		t.Error("added a chunk from another submitter")
+	}
+	if err := k.AddBundleChunk(ctx, submitAddr, id, 1, data[:10]); err == nil {
+		t.Error("added a chunk out of order")
+	}
+	if err := k.AddBundleChunk(ctx, submitAddr, id, 0, data[:10]); err != nil {
+		t.Fatal(err)
+	}
+	if err := k.AddBundleChunk(ctx, submitAddr, id, 1, data); err == nil {
+		t.Error("added a chunk past the upload size")
+	}
+	if err := k.AddBundleChunk(ctx, submitAddr, id, 1, data[10:]); err != nil {
+		t.Fatal(err)
+	}
+	upload, found := k.GetBundleUpload(ctx, id)
+	if !found || upload.ChunksReceived != 2 || upload.BytesReceived != int64(len(data)) {
+		t.Errorf("got upload %v", upload)
+	}
+
+	msg, err := k.CompleteBundleUpload(ctx, submitAddr, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg.Bundle != string(data) || !msg.Submitter.Equals(submitAddr) {
+		t.Errorf("got msg %v", msg)
+	}
+	if _, found := k.GetBundleUpload(ctx, id); found {
+		t.Error("completed upload was not forgotten")
+	}
+
+	// Data that does not match the hash is refused.
+	badID := begin(ctx, submitAddr)
+	if err := k.AddBundleChunk(ctx, submitAddr, badID, 0, bytes.ToUpper(data)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := k.CompleteBundleUpload(ctx, submitAddr, badID); err == nil {
+		t.Error("completed an upload with a mismatched hash")
+	}
+
+	// Uploads expire after their lifetime.
+	expiringID := begin(ctx, submitAddr)
+	if err := k.AddBundleChunk(ctx, submitAddr, expiringID, 0, data[:10]); err != nil {
+		t.Fatal(err)
+	}
+	lastCtx := ctx.WithBlockHeight(10 + BundleUploadLifetimeBlocks)
+	if err := k.PruneExpiredBundleUploads(lastCtx); err != nil {
+		t.Fatal(err)
+	}
+	if _, found := k.GetBundleUpload(lastCtx, expiringID); !found {
+		t.Error("upload was pruned before expiry")
+	}
+	expiredCtx := ctx.WithBlockHeight(11 + BundleUploadLifetimeBlocks)
+	if err := k.AddBundleChunk(expiredCtx, submitAddr, expiringID, 1, data[10:]); err == nil {
+		t.Error("added a chunk to an expired upload")
+	}
+	if err := k.PruneExpiredBundleUploads(expiredCtx); err != nil {
+		t.Fatal(err)
+	}
+	if _, found := k.GetBundleUpload(expiredCtx, expiringID); found {
+		t.Error("expired upload was not pruned")
+	}
+	chunkStore := prefixstore.NewStore(expiredCtx.KVStore(swingsetStoreKey), bundleChunkPrefix(expiringID))
+	if chunkStore.Has(uint64Key(0)) {
+		t.Error("expired upload chunk was not pruned")
+	}
+
+	// A submitter may only have so many open uploads.
+	for i := 0; i < MaxOpenBundleUploadsPerSubmitter; i++ {
+		begin(expiredCtx, submitAddr)
+	}
+	if _, err := k.AddBundleUpload(expiredCtx, submitAddr, hash, int64(len(data)), 0); err == nil {
+		t.Error("began too many open uploads")
+	}
+
+	// Beginning an upload discards some expired uploads of others, and all of
+	// those of its submitter.
+	laterCtx := expiredCtx.WithBlockHeight(expiredCtx.BlockHeight() + BundleUploadLifetimeBlocks + 1)
+	begin(laterCtx, utilAddr)
+	if got, want := openUploads(laterCtx, submitAddr), MaxOpenBundleUploadsPerSubmitter-ExpiredBundleUploadsPrunedPerBegin; got != want {
+		t.Errorf("got %d uploads after another submitter began, want %d", got, want)
+	}
+	begin(laterCtx, submitAddr)
+	if got := openUploads(laterCtx, submitAddr); got != 1 {
+		t.Errorf("got %d uploads after the submitter began, want 1", got)
+	}
+}
+
+func TestMsgUpdateParams(t *testing.T) {
+	paramsStoreKey := storetypes.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTStoreKey := storetypes.NewTransientStoreKey(paramstypes.TStoreKey)
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(paramsStoreKey, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsTStoreKey, storetypes.StoreTypeTransient, db)
+	if err := ms.LoadLatestVersion(); err != nil {
+		t.Fatal(err)
+	}
+	ctx := sdk.NewContext(ms, tmproto.Header{}, false, log.NewNopLogger())
+	pk := paramskeeper.NewKeeper(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), codec.NewLegacyAmino(), paramsStoreKey, paramsTStoreKey)
+	k := Keeper{paramSpace: pk.Subspace(types.ModuleName).WithKeyTable(types.ParamKeyTable())}
+	k.SetParams(ctx, types.DefaultParams())
+	msgServer := NewMsgServerImpl(k)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	entry := types.NewStringBeans(types.BeansPerInboundTx, sdkmath.NewUint(12345))
+	_, err := msgServer.UpdateParams(goCtx, types.NewMsgUpdateBeansPerUnit(submitAddr, entry))
+	if err == nil {
+		t.Errorf("params updated by non-authority")
+	}
+
+	_, err = msgServer.UpdateParams(goCtx, types.NewMsgUpdateBeansPerUnit(k.GetAuthority(), entry))
+	if err != nil {
+		t.Fatalf("UpdateParams error: %v", err)
+	}
+	want := types.DefaultParams()
+	want.BeansPerUnit = types.SetBeansPerUnit(want.BeansPerUnit, entry)
+	if got := k.GetParams(ctx); !reflect.DeepEqual(got, want) {
+		t.Errorf("got params %v, want %v", got, want)
+	}
+
+	want = types.DefaultParams()
+	want.BootstrapVatConfig = "@agoric/vm-config/decentral-test-vaults-config.json"
+	_, err = msgServer.UpdateParams(goCtx, types.NewMsgUpdateParams(k.GetAuthority(), want))
+	if err != nil {
+		t.Fatalf("UpdateParams error: %v", err)
+	}
+	if got := k.GetParams(ctx); !reflect.DeepEqual(got, want) {
+		t.Errorf("got params %v, want %v", got, want)
+	}
+}
+
+func TestPaginateEgressesAndMailboxes(t *testing.T) {
+	vstorageStoreKey := storetypes.NewKVStoreKey(vstoragetypes.StoreKey)
+	vstorageMetaStoreKey := storetypes.NewKVStoreKey(vstoragetypes.MetaStoreKey)
+	paramsStoreKey := storetypes.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTStoreKey := storetypes.NewTransientStoreKey(paramstypes.TStoreKey)
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(vstorageStoreKey, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(vstorageMetaStoreKey, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsStoreKey, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsTStoreKey, storetypes.StoreTypeTransient, db)
+	if err := ms.LoadLatestVersion(); err != nil {
+		t.Fatal(err)
+	}
+	ctx := sdk.NewContext(ms, tmproto.Header{}, false, log.NewNopLogger())
+	pk := paramskeeper.NewKeeper(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), codec.NewLegacyAmino(), paramsStoreKey, paramsTStoreKey)
+	vstorageKeeper := vstoragekeeper.NewKeeper(vstorageStoreKey, vstorageMetaStoreKey, pk.Subspace(vstoragetypes.ModuleName))
+	k := Keeper{vstorageKeeper: vstorageKeeper}
+
+	walletAddr := sdk.AccAddress([]byte("wallet"))
+	remoteAddr := sdk.AccAddress([]byte("remote"))
+	for _, egress := range []*types.Egress{
+		types.NewEgress("submitter", submitAddr, []string{types.PowerFlagSmartWallet}),
+		types.NewEgress("util", utilAddr, nil),
+		types.NewEgress("wallet", walletAddr, []string{types.PowerFlagSmartWallet}),
+		types.NewEgress("remote", remoteAddr, []string{"REMOTE_WALLET"}),
+	} {
+		bz, err := json.Marshal(egress)
+		if err != nil {
+			t.Fatal(err)
+		}
+		vstorageKeeper.SetStorage(ctx, agoric.NewKVEntry(StoragePathEgress+"."+egress.Peer.String(), string(bz)))
+	}
+	k.SetMailbox(ctx, submitAddr.String(), "submitter mail")
+	k.SetMailbox(ctx, utilAddr.String(), "util mail")
+	k.SetMailbox(ctx, "solo", "solo mail")
+
+	collectEgresses := func(powerFlag string, pageReq *query.PageRequest) ([]string, *query.PageResponse) {
+		nicknames := []string{}
+		pageRes, err := k.PaginateEgresses(ctx, powerFlag, pageReq, func(egress types.Egress) error {
+			nicknames = append(nicknames, egress.Nickname)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("PaginateEgresses: %v", err)
+		}
+		return nicknames, pageRes
+	}
+
+	all, _ := collectEgresses("", nil)
+	if len(all) != 4 {
+		t.Errorf("got egresses %v, want 4", all)
+	}
+	page, pageRes := collectEgresses(types.PowerFlagSmartWallet, &query.PageRequest{Limit: 1, CountTotal: true})
+	if len(page) != 1 || pageRes.NextKey == nil || pageRes.Total != 2 {
+		t.Errorf("first smart wallet page: got %v, %+v", page, pageRes)
+	}
+	rest, pageRes := collectEgresses(types.PowerFlagSmartWallet, &query.PageRequest{Key: pageRes.NextKey})
+	smartWallets := append(page, rest...)
+	if len(smartWallets) != 2 || pageRes.NextKey != nil {
+		t.Errorf("smart wallets: got %v, %+v", smartWallets, pageRes)
+	}
+	for _, nickname := range smartWallets {
+		if nickname != "submitter" && nickname != "wallet" {
+			t.Errorf("unexpected smart wallet %q", nickname)
+		}
+	}
+
+	collectMailboxes := func(powerFlag string) map[string]string {
+		mailboxes := map[string]string{}
+		_, err := k.PaginateMailboxes(ctx, powerFlag, nil, func(peer string, mailbox string) error {
+			mailboxes[peer] = mailbox
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("PaginateMailboxes: %v", err)
+		}
+		return mailboxes
+	}
+	expected := map[string]string{
+		submitAddr.String(): "submitter mail",
+		utilAddr.String():   "util mail",
+		"solo":              "solo mail",
+	}
+	if got := collectMailboxes(""); !reflect.DeepEqual(got, expected) {
+		t.Errorf("got mailboxes %v, want %v", got, expected)
+	}
+	expected = map[string]string{submitAddr.String(): "submitter mail"}
+	if got := collectMailboxes(types.PowerFlagSmartWallet); !reflect.DeepEqual(got, expected) {
+		t.Errorf("got smart wallet mailboxes %v, want %v", got, expected)
+	}
+}
+
+func TestPaginateInboundQueue(t *testing.T) {
+	vstorageStoreKey := storetypes.NewKVStoreKey(vstoragetypes.StoreKey)
+	vstorageMetaStoreKey := storetypes.NewKVStoreKey(vstoragetypes.MetaStoreKey)
+	paramsStoreKey := storetypes.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTStoreKey := storetypes.NewTransientStoreKey(paramstypes.TStoreKey)
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(vstorageStoreKey, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(vstorageMetaStoreKey, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsStoreKey, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsTStoreKey, storetypes.StoreTypeTransient, db)
+	if err := ms.LoadLatestVersion(); err != nil {
+		t.Fatal(err)
+	}
+	ctx := sdk.NewContext(ms, tmproto.Header{}, false, log.NewNopLogger())
+	pk := paramskeeper.NewKeeper(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), codec.NewLegacyAmino(), paramsStoreKey, paramsTStoreKey)
+	vstorageKeeper := vstoragekeeper.NewKeeper(vstorageStoreKey, vstorageMetaStoreKey, pk.Subspace(vstoragetypes.ModuleName))
+	k := Keeper{vstorageKeeper: vstorageKeeper}
+
+	// Enqueue four actions and consume the first.
+	for i := 0; i < 4; i++ {
+		record := fmt.Sprintf(`{"action":{"type":"ACTION_%d","blockHeight":5},"context":{"blockHeight":5,"txHash":"tx%d","msgIdx":%d}}`, i, i, i)
+		if err := vstorageKeeper.PushQueueItem(ctx, StoragePathActionQueue, record); err != nil {
+			t.Fatal(err)
+		}
+	}
+	vstorageKeeper.SetStorage(ctx, agoric.NewKVEntry(StoragePathActionQueue+".head", "1"))
+
+	collect := func(pageReq *query.PageRequest) ([]string, *query.PageResponse) {
+		indices := []string{}
+		pageRes, err := k.PaginateInboundQueue(ctx, StoragePathActionQueue, pageReq, func(entry types.InboundQueueEntry) error {
+			indices = append(indices, entry.Index)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("PaginateInboundQueue: %v", err)
+		}
+		return indices, pageRes
+	}
+
+	indices, pageRes := collect(&query.PageRequest{Limit: 2, CountTotal: true})
+	if !reflect.DeepEqual(indices, []string{"1", "2"}) || string(pageRes.NextKey) != "3" || pageRes.Total != 3 {
+		t.Errorf("first page: got %v, %+v", indices, pageRes)
+	}
+	indices, pageRes = collect(&query.PageRequest{Key: pageRes.NextKey, Limit: 2})
+	if !reflect.DeepEqual(indices, []string{"3"}) || pageRes.NextKey != nil {
+		t.Errorf("second page: got %v, %+v", indices, pageRes)
+	}
+	indices, _ = collect(&query.PageRequest{Offset: 2})
+	if !reflect.DeepEqual(indices, []string{"3"}) {
+		t.Errorf("offset page: got %v", indices)
+	}
+
+	var entries []types.InboundQueueEntry
+	_, err := k.PaginateInboundQueue(ctx, StoragePathActionQueue, &query.PageRequest{Limit: 1}, func(entry types.InboundQueueEntry) error {
+		entries = append(entries, entry)
+		return nil
+	})
+	expected := []types.InboundQueueEntry{{
+		Index:       "1",
+		ActionType:  "ACTION_1",
+		BlockHeight: 5,
+		TxHash:      "tx1",
+		MsgIdx:      1,
+		Action:      `{"type":"ACTION_1","blockHeight":5}`,
+	}}
+	if err != nil || !reflect.DeepEqual(entries, expected) {
+		t.Errorf("entries: got %+v, %v; want %+v", entries, err, expected)
+	}
+
+	if _, err := k.PaginateInboundQueue(ctx, StoragePathActionQueue, &query.PageRequest{Reverse: true}, nil); err == nil {
+		t.Errorf("reversed page: got no error")
+	}
+}
+
+func TestAdjustInboundPrice(t *testing.T) {
+	pricing := types.InboundPricing{
+		Enabled:            true,
+		TargetQueuePercent: 50,
+		MaxChangePercent:   10,
+		MinBeans:           sdkmath.NewUint(1_000),
+		MaxBeans:           sdkmath.NewUint(2_000),
+	}
+	for _, tt := range []struct {
+		name        string
+		price       uint64
+		queueLength int32
+		queueMax    int32
+		want        uint64
+	}{
+		{"at-target", 1_500, 50, 100, 1_500},
+		{"full", 1_500, 100, 100, 1_650},
+		{"overfull", 1_500, 1_000, 100, 1_650},
+		{"half-over", 1_500, 75, 100, 1_575},
+		{"empty", 1_500, 0, 100, 1_350},
+		{"at-max", 1_900, 100, 100, 2_000},
+		{"at-min", 1_050, 0, 100, 1_000},
+		{"no-queue-max", 1_500, 10, 0, 1_500},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got := adjustInboundPrice(pricing, sdkmath.NewUint(tt.price), tt.queueLength, tt.queueMax)
+			if !got.Equal(sdkmath.NewUint(tt.want)) {
+				t.Errorf("got %s, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMigrateParams(t *testing.T) {
+	paramsStoreKey := storetypes.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTStoreKey := storetypes.NewTransientStoreKey(paramstypes.TStoreKey)
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(paramsStoreKey, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsTStoreKey, storetypes.StoreTypeTransient, db)
+	if err := ms.LoadLatestVersion(); err != nil {
+		t.Fatal(err)
+	}
+	ctx := sdk.NewContext(ms, tmproto.Header{}, false, log.NewNopLogger())
+	pk := paramskeeper.NewKeeper(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), codec.NewLegacyAmino(), paramsStoreKey, paramsTStoreKey)
+	k := Keeper{paramSpace: pk.Subspace(types.ModuleName).WithKeyTable(types.ParamKeyTable())}
+
+	// Store all params but those added since, as before an upgrade.
+	want := types.DefaultParams()
+	want.BootstrapVatConfig = "@agoric/vm-config/decentral-test-vaults-config.json"
+	for _, pair := range want.ParamSetPairs() {
+		if !bytes.Equal(pair.Key, types.ParamStoreKeyInboundPricing) {
+			k.paramSpace.Set(ctx, pair.Key, reflect.ValueOf(pair.Value).Elem().Interface())
+		}
+	}
+
+	if err := NewMigrator(k).MigrateParams(ctx); err != nil {
+		t.Fatalf("MigrateParams error: %v", err)
+	}
+	if got := k.GetParams(ctx); !reflect.DeepEqual(got, want) {
+		t.Errorf("got params %v, want %v", got, want)
+	}
+}
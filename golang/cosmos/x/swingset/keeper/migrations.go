@@ -22,7 +22,9 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 
 // MigrateParams migrates params by setting new params to their default value
 func (m Migrator) MigrateParams(ctx sdk.Context) error {
-	params := m.keeper.GetParams(ctx)
+	// Params added since the last migration are not in the store yet.
+	var params types.Params
+	m.keeper.paramSpace.GetParamSetIfExists(ctx, &params)
 	newParams, err := types.UpdateParams(params)
 	if err != nil {
 		return err
@@ -2,10 +2,14 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
+	sdkioerrors "cosmossdk.io/errors"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 type msgServer struct {
@@ -116,6 +120,133 @@ func (keeper msgServer) WalletSpendAction(goCtx context.Context, msg *types.MsgW
 	return &types.MsgWalletSpendActionResponse{}, nil
 }
 
+type walletBatchAction struct {
+	ID            string `json:"id"`
+	CorrelationID string `json:"correlationId"`
+	Action        string `json:"action"`
+	Spend         bool   `json:"spend"`
+}
+
+type walletActionBatch struct {
+	*vm.ActionHeader `actionType:"WALLET_ACTION_BATCH"`
+	Owner            string              `json:"owner"`
+	Actions          []walletBatchAction `json:"actions"`
+}
+
+// walletBatchCorrelationID returns the ID that identifies an action of a batch
+// across all transactions.
+func walletBatchCorrelationID(ctx sdk.Context, id string) string {
+	txHash, ok := ctx.Context().Value(baseapp.TxHashContextKey).(string)
+	if !ok {
+		txHash = "unknown"
+	}
+	msgIdx, _ := ctx.Context().Value(baseapp.TxMsgIdxContextKey).(int)
+	return fmt.Sprintf("%s/%d/%s", txHash, msgIdx, id)
+}
+
+func (keeper msgServer) WalletActionBatch(goCtx context.Context, msg *types.MsgWalletActionBatch) (*types.MsgWalletActionBatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := keeper.provisionIfNeeded(ctx, msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	action := walletActionBatch{
+		Owner:   msg.Owner.String(),
+		Actions: make([]walletBatchAction, len(msg.Actions)),
+	}
+	correlationIDs := make([]string, len(msg.Actions))
+	for i, batchAction := range msg.Actions {
+		correlationIDs[i] = walletBatchCorrelationID(ctx, batchAction.Id)
+		action.Actions[i] = walletBatchAction{
+			ID:            batchAction.Id,
+			CorrelationID: correlationIDs[i],
+			Action:        batchAction.Action,
+			Spend:         batchAction.Spend,
+		}
+	}
+
+	err = keeper.routeAction(ctx, msg, action)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgWalletActionBatchResponse{CorrelationIds: correlationIDs}, nil
+}
+
+func (keeper msgServer) ScheduleAction(goCtx context.Context, msg *types.MsgScheduleAction) (*types.MsgScheduleActionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	switch {
+	case msg.NotBeforeHeight > 0 && msg.NotBeforeHeight <= ctx.BlockHeight():
+		return nil, sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "not-before height %d is not after current height %d", msg.NotBeforeHeight, ctx.BlockHeight())
+	case msg.NotBeforeTime > 0 && msg.NotBeforeTime <= ctx.BlockTime().Unix():
+		return nil, sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "not-before time %d is not after current block time %d", msg.NotBeforeTime, ctx.BlockTime().Unix())
+	}
+
+	if len(msg.CoreEvals) > 0 {
+		// Core evals are only scheduled by governance.
+		if authority := keeper.GetAuthority(); !msg.Owner.Equals(authority) {
+			return nil, sdkioerrors.Wrapf(sdkerrors.ErrUnauthorized, "only %s can schedule core evals", authority)
+		}
+	} else {
+		if keeper.GetSmartWalletState(ctx, msg.Owner) != types.SmartWalletStateProvisioned {
+			return nil, sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "smart wallet of %s is not provisioned", msg.Owner)
+		}
+		// The escrow must cover the admission of the action when it is delivered.
+		minEscrow := keeper.ScheduledActionMinEscrow(ctx, msg.Action)
+		if !msg.Escrow.IsAllGTE(minEscrow) {
+			return nil, sdkioerrors.Wrapf(sdkerrors.ErrInsufficientFee, "escrow %s is less than %s", msg.Escrow, minEscrow)
+		}
+	}
+
+	id, err := keeper.AddScheduledAction(ctx, types.ScheduledAction{
+		Owner:           msg.Owner,
+		Action:          msg.Action,
+		Spend:           msg.Spend,
+		CoreEvals:       msg.CoreEvals,
+		NotBeforeHeight: msg.NotBeforeHeight,
+		NotBeforeTime:   msg.NotBeforeTime,
+		Escrow:          msg.Escrow,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgScheduleActionResponse{Id: id}, nil
+}
+
+func (keeper msgServer) CancelScheduledAction(goCtx context.Context, msg *types.MsgCancelScheduledAction) (*types.MsgCancelScheduledActionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := keeper.RemoveScheduledAction(ctx, msg.Owner, msg.Id)
+	if err != nil {
+		return nil, sdkioerrors.Wrap(sdkerrors.ErrNotFound, err.Error())
+	}
+	return &types.MsgCancelScheduledActionResponse{}, nil
+}
+
+func (keeper msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if authority := keeper.GetAuthority(); !msg.Authority.Equals(authority) {
+		return nil, sdkioerrors.Wrapf(sdkerrors.ErrUnauthorized, "expected authority %s, got %s", authority, msg.Authority)
+	}
+
+	var params types.Params
+	if msg.Params != nil {
+		params = *msg.Params
+	} else {
+		params = keeper.GetParams(ctx)
+		params.BeansPerUnit = types.SetBeansPerUnit(params.BeansPerUnit, *msg.BeansPerUnit)
+	}
+	if err := params.ValidateBasic(); err != nil {
+		return nil, sdkioerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	keeper.SetParams(ctx, params)
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
 type provisionAction struct {
 	*vm.ActionHeader `actionType:"PLEASE_PROVISION"`
 	*types.MsgProvision
@@ -191,19 +322,64 @@ type installBundleAction struct {
 func (keeper msgServer) InstallBundle(goCtx context.Context, msg *types.MsgInstallBundle) (*types.MsgInstallBundleResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	err := msg.Uncompress()
+	err := keeper.installBundle(ctx, msg, msg)
 	if err != nil {
 		return nil, err
 	}
+
+	return &types.MsgInstallBundleResponse{}, nil
+}
+
+// installBundle uncompresses a bundle and routes it to the controller as
+// admitted by admissionMsg.
+func (keeper msgServer) installBundle(ctx sdk.Context, msg *types.MsgInstallBundle, admissionMsg vm.ControllerAdmissionMsg) error {
+	err := msg.Uncompress()
+	if err != nil {
+		return err
+	}
 	action := installBundleAction{
 		MsgInstallBundle: msg,
 	}
 
-	err = keeper.routeAction(ctx, msg, action)
-	// fmt.Fprintln(os.Stderr, "Returned from SwingSet", out, err)
+	// The controller records the bundle with a "bundleInstalled" message to
+	// the swingset port once it has installed it.
+	return keeper.routeAction(ctx, admissionMsg, action)
+}
+
+func (keeper msgServer) BeginBundleUpload(goCtx context.Context, msg *types.MsgBeginBundleUpload) (*types.MsgBeginBundleUploadResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	id, err := keeper.AddBundleUpload(ctx, msg.Submitter, msg.Sha512, msg.Size_, msg.UncompressedSize)
+	if err != nil {
+		return nil, sdkioerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return &types.MsgBeginBundleUploadResponse{UploadId: id}, nil
+}
+
+func (keeper msgServer) SendBundleChunk(goCtx context.Context, msg *types.MsgSendBundleChunk) (*types.MsgSendBundleChunkResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := keeper.AddBundleChunk(ctx, msg.Submitter, msg.UploadId, msg.Index, msg.Data)
+	if err != nil {
+		return nil, sdkioerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return &types.MsgSendBundleChunkResponse{}, nil
+}
+
+func (keeper msgServer) FinalizeBundleUpload(goCtx context.Context, msg *types.MsgFinalizeBundleUpload) (*types.MsgFinalizeBundleUploadResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	installMsg, err := keeper.CompleteBundleUpload(ctx, msg.Submitter, msg.UploadId)
 	if err != nil {
+		return nil, sdkioerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if err := installMsg.ValidateBasic(); err != nil {
 		return nil, err
 	}
 
-	return &types.MsgInstallBundleResponse{}, nil
+	err = keeper.installBundle(ctx, installMsg, msg)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgFinalizeBundleUploadResponse{}, nil
 }
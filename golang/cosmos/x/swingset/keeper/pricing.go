@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+const inboundPriceKey = "inboundPrice"
+
+// adjustInboundPrice returns the next price in beans of an inbound message,
+// raising or lowering the current price in proportion to how far the inbound
+// queue length is from its target, by at most pricing.MaxChangePercent.
+func adjustInboundPrice(pricing types.InboundPricing, price sdkmath.Uint, queueLength, queueMax int32) sdkmath.Uint {
+	if queueMax > 0 {
+		target := sdk.NewDec(int64(queueMax)).MulInt64(int64(pricing.TargetQueuePercent)).QuoInt64(100)
+		if target.IsPositive() {
+			// How far the queue is from its target, between -1 and 1.
+			pressure := sdk.NewDec(int64(queueLength)).Sub(target).Quo(target)
+			if pressure.GT(sdk.OneDec()) {
+				pressure = sdk.OneDec()
+			}
+			if pressure.LT(sdk.OneDec().Neg()) {
+				pressure = sdk.OneDec().Neg()
+			}
+			factor := sdk.OneDec().Add(pressure.MulInt64(int64(pricing.MaxChangePercent)).QuoInt64(100))
+			adjusted := sdk.NewDecFromBigInt(price.BigInt()).Mul(factor).TruncateInt()
+			price = sdkmath.NewUintFromBigInt(adjusted.BigInt())
+		}
+	}
+	if price.LT(pricing.MinBeans) {
+		return pricing.MinBeans
+	}
+	if price.GT(pricing.MaxBeans) {
+		return pricing.MaxBeans
+	}
+	return price
+}
+
+// UpdateInboundPrice adjusts the price of inbound messages for the current
+// block according to the length of the inbound queue, if congestion pricing is
+// enabled.
+func (k Keeper) UpdateInboundPrice(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+	store := ctx.KVStore(k.storeKey)
+	if !params.InboundPricing.Enabled {
+		// Start again from the minimum price if re-enabled, only writing to the
+		// store the first block after pricing is disabled.
+		if store.Has([]byte(inboundPriceKey)) {
+			store.Delete([]byte(inboundPriceKey))
+		}
+		return nil
+	}
+
+	queueMax, _ := types.QueueSizeEntry(params.QueueMax, types.QueueInbound)
+	queueLength, err := k.InboundQueueLength(ctx)
+	if err != nil {
+		return err
+	}
+	price := adjustInboundPrice(params.InboundPricing, k.getInboundPriceBeans(ctx, params), queueLength, queueMax)
+	bz, err := price.Marshal()
+	if err != nil {
+		return err
+	}
+	store.Set([]byte(inboundPriceKey), bz)
+	return nil
+}
+
+// getInboundPriceBeans returns the current price in beans of an inbound
+// message, which starts at the minimum price.
+func (k Keeper) getInboundPriceBeans(ctx sdk.Context, params types.Params) sdkmath.Uint {
+	bz := ctx.KVStore(k.storeKey).Get([]byte(inboundPriceKey))
+	if bz == nil {
+		return params.InboundPricing.MinBeans
+	}
+	var price sdkmath.Uint
+	if err := price.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return price
+}
+
+// GetInboundPrice returns the current price of an inbound message in beans
+// and as the minimum Tx fee, and whether or not congestion pricing is enabled.
+func (k Keeper) GetInboundPrice(ctx sdk.Context) (sdkmath.Uint, sdk.Coins, bool) {
+	params := k.GetParams(ctx)
+	if !params.InboundPricing.Enabled {
+		return sdkmath.ZeroUint(), nil, false
+	}
+	beans := k.getInboundPriceBeans(ctx, params)
+
+	beansPerFeeUnit := k.GetBeansPerUnit(ctx)[types.BeansPerFeeUnit]
+	if beansPerFeeUnit.IsNil() || beansPerFeeUnit.IsZero() {
+		return beans, nil, true
+	}
+	beansDec := sdk.NewDecFromBigInt(beans.BigInt())
+	beansPerFeeUnitDec := sdk.NewDecFromBigInt(beansPerFeeUnit.BigInt())
+	feeDecCoins := sdk.NewDecCoinsFromCoins(params.FeeUnitPrice...).MulDec(beansDec).QuoDec(beansPerFeeUnitDec)
+	fee, _ := feeDecCoins.TruncateDecimal()
+	return beans, fee, true
+}
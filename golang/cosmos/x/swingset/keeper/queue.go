@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+// inboundQueueRecordJSON is the stored form of an InboundQueueRecord, with
+// its action kept as raw JSON.
+type inboundQueueRecordJSON struct {
+	Action  json.RawMessage     `json:"action"`
+	Context types.ActionContext `json:"context"`
+}
+
+// decodeInboundQueueEntry decodes the JSON text of an InboundQueueRecord.
+func decodeInboundQueueEntry(index sdkmath.Int, value string) (types.InboundQueueEntry, error) {
+	var record inboundQueueRecordJSON
+	if err := json.Unmarshal([]byte(value), &record); err != nil {
+		return types.InboundQueueEntry{}, fmt.Errorf("invalid inbound queue record at %s: %w", index, err)
+	}
+	var header struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(record.Action, &header); err != nil {
+		return types.InboundQueueEntry{}, fmt.Errorf("invalid inbound queue action at %s: %w", index, err)
+	}
+	return types.InboundQueueEntry{
+		Index:       index.String(),
+		ActionType:  header.Type,
+		BlockHeight: record.Context.BlockHeight,
+		TxHash:      record.Context.TxHash,
+		MsgIdx:      int64(record.Context.MsgIdx),
+		Action:      string(record.Action),
+	}, nil
+}
+
+// PaginateInboundQueue calls onResult with each entry of the inbound queue at
+// queuePath (StoragePathActionQueue or StoragePathHighPriorityQueue), oldest
+// first, subject to pageRequest.  Pages are keyed by the decimal queue index
+// at which they start, and may not be reversed.
+func (k Keeper) PaginateInboundQueue(
+	ctx sdk.Context,
+	queuePath string,
+	pageRequest *query.PageRequest,
+	onResult func(entry types.InboundQueueEntry) error,
+) (*query.PageResponse, error) {
+	if pageRequest == nil {
+		pageRequest = &query.PageRequest{}
+	}
+	if pageRequest.Reverse {
+		return nil, fmt.Errorf("inbound queue pagination cannot be reversed")
+	}
+	if pageRequest.Offset > 0 && pageRequest.Key != nil {
+		return nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+	head, err := k.vstorageKeeper.GetIntValue(ctx, queuePath+".head")
+	if err != nil {
+		return nil, err
+	}
+	tail, err := k.vstorageKeeper.GetIntValue(ctx, queuePath+".tail")
+	if err != nil {
+		return nil, err
+	}
+
+	start := head.Add(sdkmath.NewIntFromUint64(pageRequest.Offset))
+	if pageRequest.Key != nil {
+		var ok bool
+		start, ok = sdkmath.NewIntFromString(string(pageRequest.Key))
+		if !ok {
+			return nil, fmt.Errorf("invalid pagination key %q", pageRequest.Key)
+		}
+		if start.LT(head) {
+			// The entries before the key have already been consumed.
+			start = head
+		}
+	}
+	limit := pageRequest.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	index := start
+	for n := uint64(0); n < limit && index.LT(tail); n++ {
+		value := k.vstorageKeeper.GetEntry(ctx, queuePath+"."+index.String()).StringValue()
+		entry, err := decodeInboundQueueEntry(index, value)
+		if err != nil {
+			return nil, err
+		}
+		if err := onResult(entry); err != nil {
+			return nil, err
+		}
+		index = index.AddRaw(1)
+	}
+
+	pageRes := &query.PageResponse{}
+	if index.LT(tail) {
+		pageRes.NextKey = []byte(index.String())
+	}
+	if pageRequest.CountTotal {
+		pageRes.Total = tail.Sub(head).Uint64()
+	}
+	return pageRes, nil
+}
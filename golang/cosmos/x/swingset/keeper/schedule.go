@@ -0,0 +1,361 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+const (
+	scheduledActionSeqKey         = "scheduledActionSeq"
+	scheduledActionKeyPrefix      = "scheduledAction."
+	scheduledByHeightKeyPrefix    = "scheduledByHeight."
+	scheduledByTimeKeyPrefix      = "scheduledByTime."
+	scheduledByOwnerKeyPrefix     = "scheduledByOwner."
+	scheduledActionContextTxHash  = "x/swingset"
+	scheduledActionDeliveryReason = "scheduled action"
+
+	// MaxScheduledActionsPerBlock is the number of due scheduled actions
+	// delivered at the start of a block.  Any others are delivered in following
+	// blocks.
+	MaxScheduledActionsPerBlock = 100
+
+	// MaxScheduledActionsScannedPerBlock is the number of due scheduled actions
+	// considered at the start of a block, so that actions which are not
+	// admitted do not hold back those scheduled after them.
+	MaxScheduledActionsScannedPerBlock = 1000
+)
+
+func scheduledActionKey(id uint64) []byte {
+	return append([]byte(scheduledActionKeyPrefix), uint64Key(id)...)
+}
+
+// scheduleIndexKey returns the key of a scheduled action in the index of
+// actions due at a height or time.
+func scheduleIndexKey(sa types.ScheduledAction) []byte {
+	if sa.NotBeforeHeight > 0 {
+		return append(append([]byte(scheduledByHeightKeyPrefix), uint64Key(uint64(sa.NotBeforeHeight))...), uint64Key(sa.Id)...)
+	}
+	return append(append([]byte(scheduledByTimeKeyPrefix), uint64Key(uint64(sa.NotBeforeTime))...), uint64Key(sa.Id)...)
+}
+
+func scheduledByOwnerPrefix(owner sdk.AccAddress) []byte {
+	return append([]byte(scheduledByOwnerKeyPrefix), address.MustLengthPrefix(owner)...)
+}
+
+// beansToCoins converts beans to fee coins at the current fee unit price,
+// rounding down.
+func (k Keeper) beansToCoins(ctx sdk.Context, beans sdkmath.Uint) sdk.Coins {
+	beansPerFeeUnit := k.GetBeansPerUnit(ctx)[types.BeansPerFeeUnit]
+	if beansPerFeeUnit.IsNil() || beansPerFeeUnit.IsZero() {
+		return nil
+	}
+	beansDec := sdk.NewDecFromBigInt(beans.BigInt())
+	beansPerFeeUnitDec := sdk.NewDecFromBigInt(beansPerFeeUnit.BigInt())
+	feeDecCoins := sdk.NewDecCoinsFromCoins(k.GetParams(ctx).FeeUnitPrice...).MulDec(beansDec).QuoDec(beansPerFeeUnitDec)
+	coins, _ := feeDecCoins.TruncateDecimal()
+	return coins
+}
+
+// ScheduledActionMinEscrow returns the least escrow of a scheduled wallet
+// action, which is its inbound admission charge.
+func (k Keeper) ScheduledActionMinEscrow(ctx sdk.Context, action string) sdk.Coins {
+	beansPerUnit := k.GetBeansPerUnit(ctx)
+	beans := beansPerUnit[types.BeansPerInboundTx].
+		Add(beansPerUnit[types.BeansPerMessage]).
+		Add(beansPerUnit[types.BeansPerMessageByte].MulUint64(uint64(len(action))))
+	return k.beansToCoins(ctx, beans)
+}
+
+// setScheduledAction stores and indexes a scheduled action.
+func (k Keeper) setScheduledAction(ctx sdk.Context, sa types.ScheduledAction) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(scheduledActionKey(sa.Id), k.cdc.MustMarshal(&sa))
+	store.Set(scheduleIndexKey(sa), []byte{})
+	store.Set(append(scheduledByOwnerPrefix(sa.Owner), uint64Key(sa.Id)...), []byte{})
+}
+
+// deleteScheduledAction forgets a scheduled action.
+func (k Keeper) deleteScheduledAction(ctx sdk.Context, sa types.ScheduledAction) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(scheduledActionKey(sa.Id))
+	store.Delete(scheduleIndexKey(sa))
+	store.Delete(append(scheduledByOwnerPrefix(sa.Owner), uint64Key(sa.Id)...))
+}
+
+// AddScheduledAction stores an action to be delivered at the start of the first
+// block at or after its height or time, moving its escrow from the owner to
+// the swingset module account.  It returns the ID of the scheduled action.
+func (k Keeper) AddScheduledAction(ctx sdk.Context, sa types.ScheduledAction) (uint64, error) {
+	if !sa.Escrow.IsZero() {
+		err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sa.Owner, types.ModuleName, sa.Escrow)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	var id uint64
+	if bz := store.Get([]byte(scheduledActionSeqKey)); bz != nil {
+		id = binary.BigEndian.Uint64(bz)
+	}
+	store.Set([]byte(scheduledActionSeqKey), uint64Key(id+1))
+
+	sa.Id = id
+	sa.ScheduledHeight = ctx.BlockHeight()
+	k.setScheduledAction(ctx, sa)
+	return id, nil
+}
+
+// GetScheduledAction returns a scheduled action that has not yet been
+// delivered.
+func (k Keeper) GetScheduledAction(ctx sdk.Context, id uint64) (types.ScheduledAction, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(scheduledActionKey(id))
+	if bz == nil {
+		return types.ScheduledAction{}, false
+	}
+	var sa types.ScheduledAction
+	k.cdc.MustUnmarshal(bz, &sa)
+	return sa, true
+}
+
+// RemoveScheduledAction forgets a scheduled action of an owner, refunding its
+// escrow.
+func (k Keeper) RemoveScheduledAction(ctx sdk.Context, owner sdk.AccAddress, id uint64) error {
+	sa, found := k.GetScheduledAction(ctx, id)
+	if !found || !sa.Owner.Equals(owner) {
+		return fmt.Errorf("no scheduled action %d of %s", id, owner)
+	}
+	if !sa.Escrow.IsZero() {
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sa.Owner, sa.Escrow)
+		if err != nil {
+			return err
+		}
+	}
+	k.deleteScheduledAction(ctx, sa)
+	return nil
+}
+
+// PaginateScheduledActions calls onResult with each scheduled action of an
+// owner, in order of ID, subject to pageRequest.
+func (k Keeper) PaginateScheduledActions(
+	ctx sdk.Context,
+	owner sdk.AccAddress,
+	pageRequest *query.PageRequest,
+	onResult func(sa types.ScheduledAction) error,
+) (*query.PageResponse, error) {
+	ownerStore := prefix.NewStore(ctx.KVStore(k.storeKey), scheduledByOwnerPrefix(owner))
+	return query.Paginate(ownerStore, pageRequest, func(key []byte, _ []byte) error {
+		sa, found := k.GetScheduledAction(ctx, binary.BigEndian.Uint64(key))
+		if !found {
+			return fmt.Errorf("missing scheduled action %d", binary.BigEndian.Uint64(key))
+		}
+		return onResult(sa)
+	})
+}
+
+// GetAllScheduledActions returns every scheduled action in order of ID.
+func (k Keeper) GetAllScheduledActions(ctx sdk.Context) []types.ScheduledAction {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), []byte(scheduledActionKeyPrefix))
+	defer iterator.Close()
+
+	actions := []types.ScheduledAction{}
+	for ; iterator.Valid(); iterator.Next() {
+		var sa types.ScheduledAction
+		k.cdc.MustUnmarshal(iterator.Value(), &sa)
+		actions = append(actions, sa)
+	}
+	return actions
+}
+
+// SetScheduledActions stores scheduled actions from genesis, whose escrow is
+// already held by the swingset module account.
+func (k Keeper) SetScheduledActions(ctx sdk.Context, actions []types.ScheduledAction) {
+	store := ctx.KVStore(k.storeKey)
+	var nextID uint64
+	if bz := store.Get([]byte(scheduledActionSeqKey)); bz != nil {
+		nextID = binary.BigEndian.Uint64(bz)
+	}
+	for _, sa := range actions {
+		k.setScheduledAction(ctx, sa)
+		if sa.Id >= nextID {
+			nextID = sa.Id + 1
+		}
+	}
+	store.Set([]byte(scheduledActionSeqKey), uint64Key(nextID))
+}
+
+// dueScheduledActionKeys returns the index keys of up to limit actions
+// scheduled at or before a height or time, as indexed under keyPrefix.
+func (k Keeper) dueScheduledActionKeys(ctx sdk.Context, keyPrefix string, due int64, limit int) [][]byte {
+	store := ctx.KVStore(k.storeKey)
+	start := []byte(keyPrefix)
+	end := append([]byte(keyPrefix), uint64Key(uint64(due)+1)...)
+	iterator := store.Iterator(start, end)
+	defer iterator.Close()
+
+	keys := [][]byte{}
+	for ; iterator.Valid() && len(keys) < limit; iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	return keys
+}
+
+// scheduleIndexID returns the scheduled action ID of an index key.
+func scheduleIndexID(key []byte) uint64 {
+	return binary.BigEndian.Uint64(key[len(key)-8:])
+}
+
+// DeliverScheduledActions pushes the actions which are due at the current
+// block height or time onto the inbound queues, paying their escrow to the fee
+// collector.  Actions are admitted against the same inbound queue limits and
+// per-block budgets as inbound messages, and those that are not admitted are
+// left to be delivered in a following block.  An action that fails to be
+// delivered is dropped and its escrow refunded, without affecting the others.
+func (k Keeper) DeliverScheduledActions(ctx sdk.Context) error {
+	keys := k.dueScheduledActionKeys(ctx, scheduledByHeightKeyPrefix, ctx.BlockHeight(), MaxScheduledActionsScannedPerBlock)
+	if blockTime := ctx.BlockTime().Unix(); blockTime > 0 && len(keys) < MaxScheduledActionsScannedPerBlock {
+		keys = append(keys, k.dueScheduledActionKeys(ctx, scheduledByTimeKeyPrefix, blockTime, MaxScheduledActionsScannedPerBlock-len(keys))...)
+	}
+
+	// Scheduled actions have no transaction, so synthesize unique context
+	// information from a placeholder txHash and their order in the block.
+	ctx = ctx.WithContext(context.WithValue(ctx.Context(), baseapp.TxHashContextKey, scheduledActionContextTxHash))
+	state := k.GetState(ctx)
+	inboundsAllowed, _ := types.QueueSizeEntry(state.QueueAllowed, types.QueueInbound)
+	delivered := 0
+	for i, key := range keys {
+		if delivered >= MaxScheduledActionsPerBlock {
+			break
+		}
+		id := scheduleIndexID(key)
+		sa, found := k.GetScheduledAction(ctx, id)
+		if !found {
+			ctx.Logger().Error("removing index of missing scheduled action", "id", id)
+			ctx.KVStore(k.storeKey).Delete(key)
+			continue
+		}
+		highPriority, err := k.isHighPriorityScheduledAction(ctx, sa)
+		if err != nil {
+			return err
+		}
+		budgetKeys, admitted := k.admitScheduledAction(ctx, state, sa)
+		if !highPriority && (!admitted || inboundsAllowed <= 0) {
+			continue
+		}
+
+		actionCtx, writeCache := ctx.WithContext(context.WithValue(ctx.Context(), baseapp.TxMsgIdxContextKey, i)).CacheContext()
+		if err := k.deliverScheduledAction(actionCtx, sa, highPriority); err != nil {
+			ctx.Logger().Error("dropping undeliverable scheduled action", "id", sa.Id, "owner", sa.Owner.String(), "error", err)
+			k.refundScheduledAction(ctx, sa)
+		} else {
+			writeCache()
+			delivered++
+			inboundsAllowed--
+			for _, key := range budgetKeys {
+				k.AddInboundAdmissions(ctx, key, 1)
+			}
+		}
+		k.deleteScheduledAction(ctx, sa)
+	}
+	return nil
+}
+
+// isHighPriorityScheduledAction returns whether a scheduled action is delivered
+// to the highPriorityQueue, exempt from the inbound queue limits.
+func (k Keeper) isHighPriorityScheduledAction(ctx sdk.Context, sa types.ScheduledAction) (bool, error) {
+	switch {
+	case len(sa.CoreEvals) > 0:
+		return true, nil
+	case sa.Spend:
+		return k.IsHighPriorityAddress(ctx, sa.Owner)
+	}
+	return false, nil
+}
+
+// admitScheduledAction returns the keys of the per-block admission budgets
+// that a scheduled action counts towards, and whether it is within all of them
+// as the equivalent inbound message would need to be.
+func (k Keeper) admitScheduledAction(ctx sdk.Context, state types.State, sa types.ScheduledAction) ([]string, bool) {
+	if len(sa.CoreEvals) > 0 {
+		return nil, true
+	}
+	var msg sdk.Msg = &types.MsgWalletAction{}
+	if sa.Spend {
+		msg = &types.MsgWalletSpendAction{}
+	}
+	typeKey := types.QueueInboundMsgTypePrefix + sdk.MsgTypeURL(msg)
+	budgetKeys := []string{}
+	admitted := true
+	for _, budget := range []struct {
+		entry, key string
+	}{
+		{typeKey, typeKey},
+		{types.QueueInboundSender, types.QueueInboundSender + ":" + sa.Owner.String()},
+	} {
+		size, found := types.QueueSizeEntry(state.QueueAllowed, budget.entry)
+		if !found {
+			continue
+		}
+		budgetKeys = append(budgetKeys, budget.key)
+		if k.GetInboundAdmissions(ctx, budget.key) >= size {
+			admitted = false
+		}
+	}
+	return budgetKeys, admitted
+}
+
+// refundScheduledAction returns the escrow of a dropped scheduled action to
+// its owner.
+func (k Keeper) refundScheduledAction(ctx sdk.Context, sa types.ScheduledAction) {
+	if sa.Escrow.IsZero() {
+		return
+	}
+	refundCtx, writeCache := ctx.CacheContext()
+	err := k.bankKeeper.SendCoinsFromModuleToAccount(refundCtx, types.ModuleName, sa.Owner, sa.Escrow)
+	if err != nil {
+		ctx.Logger().Error("cannot refund scheduled action escrow", "id", sa.Id, "owner", sa.Owner.String(), "escrow", sa.Escrow.String(), "error", err)
+		return
+	}
+	writeCache()
+}
+
+func (k Keeper) deliverScheduledAction(ctx sdk.Context, sa types.ScheduledAction, highPriority bool) error {
+	var action vm.Action
+	switch {
+	case len(sa.CoreEvals) > 0:
+		action = coreEvalAction{Evals: sa.CoreEvals}
+	case sa.Spend:
+		action = walletSpendAction{Owner: sa.Owner.String(), SpendAction: sa.Action}
+	default:
+		action = walletAction{Owner: sa.Owner.String(), Action: sa.Action}
+	}
+
+	if !sa.Escrow.IsZero() {
+		err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, sa.Escrow)
+		if err != nil {
+			return err
+		}
+		k.recordCharge(ctx, sa.Owner, types.ChargeRecord{
+			ChargeType: types.ChargeTypeScheduledAction,
+			Beans:      sdkmath.ZeroUint(),
+			Debited:    sa.Escrow,
+			BeansOwing: k.GetBeansOwing(ctx, sa.Owner),
+		})
+	}
+
+	if highPriority {
+		return k.PushHighPriorityAction(ctx, action)
+	}
+	return k.PushAction(ctx, action)
+}
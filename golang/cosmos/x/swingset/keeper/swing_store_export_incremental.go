@@ -0,0 +1,207 @@
+package keeper
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+// An incremental swing-store export only contains the artifacts which are not
+// part of a previous "parent" export, which may itself be incremental. Its
+// manifest chains to the parent by block height and manifest hash, and lists
+// the names of the omitted artifacts. Since swing-store artifact names
+// identify immutable content (bundle IDs, heap snapshot positions and
+// transcript span bounds), an artifact with the same name as one in the parent
+// chain is unchanged.
+//
+// The JS swing-store is given the parent export directory with
+// SwingStoreExportOptions.ParentExportDir, so that it does not read or write
+// the artifacts of the parent chain again.
+//
+// An incremental export cannot be restored directly. The chain of exports
+// starting with a full export is first merged into a full export with
+// MergeSwingStoreExportDirectories.
+
+// exportManifestParent identifies the parent of an incremental export.
+type exportManifestParent struct {
+	// BlockHeight is the block height of the parent export.
+	BlockHeight uint64 `json:"blockHeight"`
+	// ManifestHash is the "sha256:<hex>" hash of the parent export manifest file.
+	ManifestHash string `json:"manifestHash"`
+}
+
+// incrementalExportParent is what an incremental export needs to know about
+// its parent export.
+type incrementalExportParent struct {
+	exportManifestParent
+	// artifactNames are the names of all artifacts of the parent export,
+	// whether included in the parent or part of its own parent chain.
+	artifactNames map[string]bool
+}
+
+// readExportManifest reads and parses the manifest of the export in exportDir,
+// returning it alongside the hash of the manifest file.
+func readExportManifest(exportDir string) (exportManifest, string, error) {
+	var manifest exportManifest
+	rawManifest, err := os.ReadFile(filepath.Join(exportDir, ExportManifestFilename))
+	if err != nil {
+		return manifest, "", err
+	}
+	err = json.Unmarshal(rawManifest, &manifest)
+	if err != nil {
+		return manifest, "", fmt.Errorf("cannot parse %s: %w", filepath.Join(exportDir, ExportManifestFilename), err)
+	}
+	return manifest, fmt.Sprintf("sha256:%x", sha256.Sum256(rawManifest)), nil
+}
+
+// WriteIncrementalSwingStoreExportToDirectory consumes a provider and saves an
+// incremental swing-store export to disk in the provided directory, like
+// WriteSwingStoreExportToDirectory but omitting the artifacts of the export in
+// parentExportDir.  The export data is always saved in full.  The provider
+// should be for an export with the same ParentExportDir option, but any
+// artifacts of the parent chain that it provides are still omitted.
+func WriteIncrementalSwingStoreExportToDirectory(provider SwingStoreExportProvider, exportDir string, parentExportDir string) error {
+	parentManifest, parentManifestHash, err := readExportManifest(parentExportDir)
+	if err != nil {
+		return err
+	}
+	if provider.BlockHeight <= parentManifest.BlockHeight {
+		return fmt.Errorf("swing-store export height %d must be after parent export height %d", provider.BlockHeight, parentManifest.BlockHeight)
+	}
+
+	parent := &incrementalExportParent{
+		exportManifestParent: exportManifestParent{
+			BlockHeight:  parentManifest.BlockHeight,
+			ManifestHash: parentManifestHash,
+		},
+		artifactNames: map[string]bool{},
+	}
+	for _, entry := range parentManifest.Artifacts {
+		parent.artifactNames[entry[0]] = true
+	}
+	for _, name := range parentManifest.ParentArtifacts {
+		parent.artifactNames[name] = true
+	}
+
+	return writeSwingStoreExportToDirectory(provider, exportDir, parent)
+}
+
+// artifactLocation is the file containing an artifact of a chain of exports.
+type artifactLocation struct {
+	exportDir string
+	fileName  string
+}
+
+// MergeSwingStoreExportDirectories saves to mergedExportDir the full export
+// equivalent to the last of exportDirs, a chain of exports ordered by height,
+// starting with a full export and each following export being incremental
+// from the previous one.
+func MergeSwingStoreExportDirectories(exportDirs []string, mergedExportDir string) error {
+	provider, err := OpenMergedSwingStoreExportDirectories(exportDirs)
+	if err != nil {
+		return err
+	}
+	return WriteSwingStoreExportToDirectory(provider, mergedExportDir)
+}
+
+// OpenMergedSwingStoreExportDirectories checks that exportDirs are a chain of
+// exports as for MergeSwingStoreExportDirectories, and creates a provider of
+// the full export equivalent to the last of them.
+func OpenMergedSwingStoreExportDirectories(exportDirs []string) (SwingStoreExportProvider, error) {
+	if len(exportDirs) == 0 {
+		return SwingStoreExportProvider{}, fmt.Errorf("no swing-store exports to merge")
+	}
+
+	var manifest exportManifest
+	var manifestHash string
+	var names []string
+	locations := map[string]artifactLocation{}
+	for i, exportDir := range exportDirs {
+		parent := exportManifestParent{BlockHeight: manifest.BlockHeight, ManifestHash: manifestHash}
+		var err error
+		manifest, manifestHash, err = readExportManifest(exportDir)
+		if err != nil {
+			return SwingStoreExportProvider{}, err
+		}
+
+		switch {
+		case i == 0 && manifest.Parent != nil:
+			return SwingStoreExportProvider{}, fmt.Errorf("first swing-store export %s must be a full export", exportDir)
+		case i > 0 && manifest.Parent == nil:
+			return SwingStoreExportProvider{}, fmt.Errorf("swing-store export %s is not incremental", exportDir)
+		case i > 0 && *manifest.Parent != parent:
+			return SwingStoreExportProvider{}, fmt.Errorf("swing-store export %s is not incremental from %s", exportDir, exportDirs[i-1])
+		}
+
+		names = nil
+		parentLocations := locations
+		locations = map[string]artifactLocation{}
+		for _, entry := range manifest.Artifacts {
+			names = append(names, entry[0])
+			locations[entry[0]] = artifactLocation{exportDir: exportDir, fileName: entry[1]}
+		}
+		for _, name := range manifest.ParentArtifacts {
+			location, found := parentLocations[name]
+			if !found {
+				return SwingStoreExportProvider{}, fmt.Errorf("artifact %s of swing-store export %s is missing from its parents", name, exportDir)
+			}
+			names = append(names, name)
+			locations[name] = location
+		}
+	}
+
+	// Check that the artifacts are all present before anything is written.
+	for _, name := range names {
+		location := locations[name]
+		_, err := os.Stat(filepath.Join(location.exportDir, location.fileName))
+		if err != nil {
+			return SwingStoreExportProvider{}, err
+		}
+	}
+
+	lastExportDir := exportDirs[len(exportDirs)-1]
+	dataFileName := manifest.Data
+	nextArtifact := 0
+	return SwingStoreExportProvider{
+		BlockHeight: manifest.BlockHeight,
+		GetExportDataReader: func() (agoric.KVEntryReader, error) {
+			if dataFileName == "" {
+				return nil, nil
+			}
+			dataFile, err := os.Open(filepath.Join(lastExportDir, dataFileName))
+			if err != nil {
+				return nil, err
+			}
+			return agoric.NewJsonlKVEntryDecoderReader(dataFile), nil
+		},
+		ReadNextArtifact: func() (artifact types.SwingStoreArtifact, err error) {
+			if nextArtifact == len(names) {
+				return artifact, io.EOF
+			}
+			artifact.Name = names[nextArtifact]
+			nextArtifact++
+			location := locations[artifact.Name]
+			artifact.Data, err = os.ReadFile(filepath.Join(location.exportDir, location.fileName))
+			return artifact, err
+		},
+		OpenNextArtifact: func() (string, io.ReadCloser, error) {
+			if nextArtifact == len(names) {
+				return "", nil, io.EOF
+			}
+			name := names[nextArtifact]
+			nextArtifact++
+			location := locations[name]
+			artifactFile, err := os.Open(filepath.Join(location.exportDir, location.fileName))
+			if err != nil {
+				return "", nil, err
+			}
+			return name, artifactFile, nil
+		},
+	}, nil
+}
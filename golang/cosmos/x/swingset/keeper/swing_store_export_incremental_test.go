@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+// newTestExportProvider returns a provider of a swing-store export with an
+// artifact for each name, whose content is the name itself.
+func newTestExportProvider(blockHeight uint64, names ...string) SwingStoreExportProvider {
+	nextArtifact := 0
+	return SwingStoreExportProvider{
+		BlockHeight: blockHeight,
+		GetExportDataReader: func() (agoric.KVEntryReader, error) {
+			return agoric.NewSwingStoreExportDataEntriesReader([]*types.SwingStoreExportDataEntry{
+				{Key: "height", Value: strings.Repeat("+", int(blockHeight))},
+			}), nil
+		},
+		ReadNextArtifact: func() (types.SwingStoreArtifact, error) {
+			if nextArtifact == len(names) {
+				return types.SwingStoreArtifact{}, io.EOF
+			}
+			nextArtifact++
+			name := names[nextArtifact-1]
+			return types.SwingStoreArtifact{Name: name, Data: []byte(name)}, nil
+		},
+	}
+}
+
+// readTestExport reads back the export data and artifacts of an export.
+func readTestExport(t *testing.T, exportDir string) (uint64, string, map[string]string) {
+	provider, err := OpenSwingStoreExportDirectory(exportDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exportDataReader, err := provider.GetExportDataReader()
+	if err != nil {
+		t.Fatal(err)
+	}
+	entry, err := exportDataReader.Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	exportDataReader.Close()
+
+	artifacts := map[string]string{}
+	for {
+		artifact, err := provider.ReadNextArtifact()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		artifacts[artifact.Name] = string(artifact.Data)
+	}
+	return provider.BlockHeight, entry.StringValue(), artifacts
+}
+
+func TestIncrementalSwingStoreExport(t *testing.T) {
+	fullDir := t.TempDir()
+	err := WriteSwingStoreExportToDirectory(newTestExportProvider(1, "bundle.b1-a", "snapshot.v1.4"), fullDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	incDir2 := t.TempDir()
+	err = WriteIncrementalSwingStoreExportToDirectory(newTestExportProvider(2, "bundle.b1-a", "snapshot.v1.8"), incDir2, fullDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The JS swing-store omits the artifacts of the parent export chain.
+	incDir3 := t.TempDir()
+	provider3 := newTestExportProvider(3, "bundle.b1-c")
+	provider3.ParentArtifacts = []string{"bundle.b1-a", "snapshot.v1.8"}
+	err = WriteIncrementalSwingStoreExportToDirectory(provider3, incDir3, incDir2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	manifest, _, err := readExportManifest(incDir3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, incManifestHash2, err := readExportManifest(incDir2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if manifest.Parent == nil || *manifest.Parent != (exportManifestParent{BlockHeight: 2, ManifestHash: incManifestHash2}) {
+		t.Errorf("got parent %+v", manifest.Parent)
+	}
+	if len(manifest.Artifacts) != 1 || manifest.Artifacts[0][0] != "bundle.b1-c" {
+		t.Errorf("got artifacts %q", manifest.Artifacts)
+	}
+	if !reflect.DeepEqual(manifest.ParentArtifacts, []string{"bundle.b1-a", "snapshot.v1.8"}) {
+		t.Errorf("got parent artifacts %q", manifest.ParentArtifacts)
+	}
+
+	report, err := VerifySwingStoreExportDirectory(incDir3, SwingStoreExportVerifyOptions{BlockHeight: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.Incremental || report.ParentArtifacts != 2 {
+		t.Errorf("got report %+v", report)
+	}
+
+	if _, err := OpenSwingStoreExportDirectory(incDir3); err == nil || !strings.Contains(err.Error(), "incremental") {
+		t.Errorf("got error %v opening incremental export", err)
+	}
+
+	mergedDir := t.TempDir()
+	err = MergeSwingStoreExportDirectories([]string{fullDir, incDir2, incDir3}, mergedDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	blockHeight, exportData, artifacts := readTestExport(t, mergedDir)
+	if blockHeight != 3 || exportData != "+++" {
+		t.Errorf("got merged export at height %d with export data %q", blockHeight, exportData)
+	}
+	names := make([]string, 0, len(artifacts))
+	for name, data := range artifacts {
+		names = append(names, name)
+		if data != name {
+			t.Errorf("got artifact %s content %q", name, data)
+		}
+	}
+	sort.Strings(names)
+	if !reflect.DeepEqual(names, []string{"bundle.b1-a", "bundle.b1-c", "snapshot.v1.8"}) {
+		t.Errorf("got merged artifacts %q", names)
+	}
+}
+
+func TestIncrementalSwingStoreExportErrors(t *testing.T) {
+	fullDir := t.TempDir()
+	err := WriteSwingStoreExportToDirectory(newTestExportProvider(2, "bundle.b1-a"), fullDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = WriteIncrementalSwingStoreExportToDirectory(newTestExportProvider(2, "bundle.b1-a"), t.TempDir(), fullDir)
+	if err == nil || !strings.Contains(err.Error(), "must be after parent export height 2") {
+		t.Errorf("got error %v for incremental export at parent height", err)
+	}
+
+	provider := newTestExportProvider(3)
+	provider.ParentArtifacts = []string{"bundle.b1-b"}
+	err = WriteIncrementalSwingStoreExportToDirectory(provider, t.TempDir(), fullDir)
+	if err == nil || !strings.Contains(err.Error(), "missing from its parent export") {
+		t.Errorf("got error %v for omitted artifact missing from the parent", err)
+	}
+	err = WriteSwingStoreExportToDirectory(provider, t.TempDir())
+	if err == nil || !strings.Contains(err.Error(), "missing from its parent export") {
+		t.Errorf("got error %v for omitted artifact of a full export", err)
+	}
+
+	otherFullDir := t.TempDir()
+	err = WriteSwingStoreExportToDirectory(newTestExportProvider(2, "bundle.b1-b"), otherFullDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	incDir := t.TempDir()
+	err = WriteIncrementalSwingStoreExportToDirectory(newTestExportProvider(3, "bundle.b1-a"), incDir, fullDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		name       string
+		exportDirs []string
+		err        string
+	}{
+		{"no exports", nil, "no swing-store exports"},
+		{"incremental first", []string{incDir}, "must be a full export"},
+		{"full after first", []string{fullDir, otherFullDir}, "is not incremental"},
+		{"wrong parent", []string{otherFullDir, incDir}, "is not incremental from"},
+		{"missing export", []string{fullDir, t.TempDir()}, "no such file"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := MergeSwingStoreExportDirectories(tt.exportDirs, t.TempDir())
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("got error %v, want %q", err, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,339 @@
+package keeper
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+)
+
+// SwingStoreExportVerifyOptions are the expectations against which
+// VerifySwingStoreExportDirectory checks a swing-store export.
+type SwingStoreExportVerifyOptions struct {
+	// BlockHeight is the expected block height of the export, or 0 to accept
+	// any.
+	BlockHeight uint64
+	// ExportDataHash is the expected "sha256:<hex>" hash of the export data, as
+	// found in the swingset genesis state, or "" to accept any.
+	ExportDataHash string
+}
+
+// SwingStoreExportReport describes the outcome of verifying a swing-store
+// export directory.
+type SwingStoreExportReport struct {
+	// BlockHeight is the block height recorded in the export manifest.
+	BlockHeight uint64
+	// ExportDataEntries is the number of "export data" entries.
+	ExportDataEntries int
+	// ExportDataHash is the "sha256:<hex>" hash of the export data, computed as
+	// for the swingset genesis state.
+	ExportDataHash string
+	// Artifacts is the number of artifacts listed in the manifest.
+	Artifacts int
+	// VerifiedArtifacts is the number of artifacts whose content was verified
+	// against the export data.
+	VerifiedArtifacts int
+	// UnverifiedArtifacts are the names of artifacts whose content can only be
+	// verified by the JS swing-store import, such as bundles.
+	UnverifiedArtifacts []string
+	// Incremental is whether the export is incremental from a parent export.
+	Incremental bool
+	// ParentArtifacts is the number of artifacts of an incremental export which
+	// are part of its parent export chain, and cannot be verified on their own.
+	ParentArtifacts int
+	// Problems describes every missing or corrupt part of the export.
+	Problems []string
+}
+
+// OK returns whether the export has no problems.
+func (report *SwingStoreExportReport) OK() bool {
+	return len(report.Problems) == 0
+}
+
+func (report *SwingStoreExportReport) addProblem(format string, args ...interface{}) {
+	report.Problems = append(report.Problems, fmt.Sprintf(format, args...))
+}
+
+// swingStoreSnapshotRecord is the "export data" value describing a heap
+// snapshot, as written by packages/swing-store/src/snapStore.js
+type swingStoreSnapshotRecord struct {
+	Hash  string `json:"hash"`
+	InUse int    `json:"inUse"`
+}
+
+// swingStoreTranscriptSpanRecord is the "export data" value describing a
+// transcript span, as written by packages/swing-store/src/transcriptStore.js
+type swingStoreTranscriptSpanRecord struct {
+	VatID     string `json:"vatID"`
+	StartPos  uint64 `json:"startPos"`
+	EndPos    uint64 `json:"endPos"`
+	Hash      string `json:"hash"`
+	IsCurrent int    `json:"isCurrent"`
+}
+
+// transcriptSpanInitialHash is the seed of the cumulative hash of a transcript
+// span, as computed by packages/swing-store/src/transcriptStore.js
+var transcriptSpanInitialHash = sha256Hex([]byte("start of transcript span"))
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// updateTranscriptSpanHash returns the cumulative hash of a transcript span
+// after an item, as computed by packages/swing-store/src/transcriptStore.js
+func updateTranscriptSpanHash(priorHash, item string) string {
+	return sha256Hex([]byte(priorHash + sha256Hex([]byte(item))))
+}
+
+// VerifySwingStoreExportDirectory checks a swing-store export saved on disk by
+// WriteSwingStoreExportToDirectory without involving the JS side: that the
+// manifest and export data parse and match the expectations, that every
+// listed artifact is present, that heap snapshot and transcript span artifacts
+// match the hashes in the export data, and that every artifact required by
+// the export data is listed, or part of the parent chain of an incremental
+// export.  It returns an error only if the manifest cannot be read; anything
+// else is reported as a problem.
+func VerifySwingStoreExportDirectory(exportDir string, options SwingStoreExportVerifyOptions) (*SwingStoreExportReport, error) {
+	rawManifest, err := os.ReadFile(filepath.Join(exportDir, ExportManifestFilename))
+	if err != nil {
+		return nil, err
+	}
+	var manifest exportManifest
+	err = json.Unmarshal(rawManifest, &manifest)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse %s: %w", ExportManifestFilename, err)
+	}
+
+	report := &SwingStoreExportReport{
+		BlockHeight: manifest.BlockHeight,
+		Artifacts:   len(manifest.Artifacts),
+	}
+	if options.BlockHeight != 0 && manifest.BlockHeight != 0 && manifest.BlockHeight != options.BlockHeight {
+		report.addProblem("manifest block height %d does not match expected %d", manifest.BlockHeight, options.BlockHeight)
+	}
+	report.Incremental = manifest.Parent != nil
+	report.ParentArtifacts = len(manifest.ParentArtifacts)
+
+	exportData := map[string]string{}
+	if manifest.Data == "" {
+		report.addProblem("manifest lists no export data")
+	} else {
+		readSwingStoreExportData(filepath.Join(exportDir, manifest.Data), exportData, report)
+	}
+	if options.ExportDataHash != "" && report.ExportDataHash != "" && report.ExportDataHash != options.ExportDataHash {
+		report.addProblem("export data hash %s does not match expected %s", report.ExportDataHash, options.ExportDataHash)
+	}
+
+	listed := map[string]bool{}
+	for _, entry := range manifest.Artifacts {
+		name, fileName := entry[0], entry[1]
+		if listed[name] {
+			report.addProblem("artifact %s is listed more than once", name)
+			continue
+		}
+		listed[name] = true
+		if name == UntrustedExportDataArtifactName {
+			report.addProblem("unexpected artifact %s", name)
+			continue
+		}
+
+		verified, err := verifySwingStoreArtifact(name, filepath.Join(exportDir, fileName), exportData)
+		switch {
+		case err != nil:
+			report.addProblem("artifact %s (%s): %s", name, fileName, err)
+		case verified:
+			report.VerifiedArtifacts++
+		default:
+			report.UnverifiedArtifacts = append(report.UnverifiedArtifacts, name)
+		}
+	}
+
+	for _, name := range manifest.ParentArtifacts {
+		if listed[name] {
+			report.addProblem("artifact %s is listed more than once", name)
+		}
+		listed[name] = true
+	}
+
+	for _, name := range requiredSwingStoreArtifacts(exportData) {
+		if !listed[name] {
+			report.addProblem("artifact %s required by the export data is missing", name)
+		}
+	}
+
+	return report, nil
+}
+
+// readSwingStoreExportData reads the export data file into exportData,
+// recording its size and hash in the report.
+func readSwingStoreExportData(path string, exportData map[string]string, report *SwingStoreExportReport) {
+	dataFile, err := os.Open(path)
+	if err != nil {
+		report.addProblem("export data: %s", err)
+		return
+	}
+	reader := agoric.NewJsonlKVEntryDecoderReader(dataFile)
+	defer reader.Close()
+
+	hasher := sha256.New()
+	encoder := json.NewEncoder(hasher)
+	encoder.SetEscapeHTML(false)
+	for {
+		entry, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			report.addProblem("export data entry %d: %s", report.ExportDataEntries+1, err)
+			return
+		}
+		report.ExportDataEntries++
+		if !entry.HasValue() {
+			report.addProblem("export data entry %s has no value", entry.Key())
+		}
+		exportData[entry.Key()] = entry.StringValue()
+		err = encoder.Encode(entry)
+		if err != nil {
+			report.addProblem("export data entry %s: %s", entry.Key(), err)
+			return
+		}
+	}
+	report.ExportDataHash = fmt.Sprintf("sha256:%x", hasher.Sum(nil))
+}
+
+// verifySwingStoreArtifact checks an artifact file against the export data.
+// It returns whether the content of the artifact could be verified.
+func verifySwingStoreArtifact(name, path string, exportData map[string]string) (bool, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return false, err
+	}
+	defer file.Close()
+
+	parts := strings.Split(name, ".")
+	switch {
+	case parts[0] == "snapshot" && len(parts) == 3:
+		return true, verifySnapshotArtifact(name, file, exportData)
+	case parts[0] == "transcript" && len(parts) == 4:
+		return true, verifyTranscriptArtifact(parts[1], parts[2], parts[3], file, exportData)
+	case parts[0] == "bundle" && len(parts) == 2:
+		// Bundle content is checked against its ID by the JS import.
+		value, found := exportData[name]
+		if !found {
+			return false, errors.New("no export data entry")
+		}
+		if value != parts[1] {
+			return false, fmt.Errorf("export data entry is %q", value)
+		}
+		return false, nil
+	default:
+		return false, nil
+	}
+}
+
+func verifySnapshotArtifact(name string, file io.Reader, exportData map[string]string) error {
+	value, found := exportData[name]
+	if !found {
+		return errors.New("no export data entry")
+	}
+	var record swingStoreSnapshotRecord
+	if err := json.Unmarshal([]byte(value), &record); err != nil {
+		return fmt.Errorf("cannot parse export data entry: %w", err)
+	}
+
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, file); err != nil {
+		return err
+	}
+	if hash := hex.EncodeToString(hasher.Sum(nil)); hash != record.Hash {
+		return fmt.Errorf("hash %s does not match export data hash %s", hash, record.Hash)
+	}
+	return nil
+}
+
+func verifyTranscriptArtifact(vatID, rawStartPos, rawEndPos string, file io.Reader, exportData map[string]string) error {
+	startPos, err := strconv.ParseUint(rawStartPos, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid start position %q", rawStartPos)
+	}
+	endPos, err := strconv.ParseUint(rawEndPos, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid end position %q", rawEndPos)
+	}
+
+	// The current span of a vat is keyed as such rather than by position.
+	var record swingStoreTranscriptSpanRecord
+	value, found := exportData[fmt.Sprintf("transcript.%s.%d", vatID, startPos)]
+	if !found {
+		value, found = exportData[fmt.Sprintf("transcript.%s.current", vatID)]
+	}
+	if !found {
+		return errors.New("no export data entry")
+	}
+	if err := json.Unmarshal([]byte(value), &record); err != nil {
+		return fmt.Errorf("cannot parse export data entry: %w", err)
+	}
+	if record.StartPos != startPos || record.EndPos != endPos {
+		return fmt.Errorf("export data entry spans %d to %d", record.StartPos, record.EndPos)
+	}
+
+	hash := transcriptSpanInitialHash
+	items := uint64(0)
+	reader := bufio.NewReader(file)
+	for {
+		line, err := reader.ReadString('\n')
+		if line != "" {
+			hash = updateTranscriptSpanHash(hash, strings.TrimRightFunc(line, unicode.IsSpace))
+			items++
+		}
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+	}
+	if items != endPos-startPos {
+		return fmt.Errorf("has %d items instead of %d", items, endPos-startPos)
+	}
+	if hash != record.Hash {
+		return fmt.Errorf("hash %s does not match export data hash %s", hash, record.Hash)
+	}
+	return nil
+}
+
+// requiredSwingStoreArtifacts returns the names of the artifacts which an
+// operational export must contain according to its export data: every bundle,
+// every heap snapshot in use, and the current transcript span of every vat.
+func requiredSwingStoreArtifacts(exportData map[string]string) []string {
+	names := []string{}
+	for key, value := range exportData {
+		parts := strings.Split(key, ".")
+		switch {
+		case parts[0] == "bundle" && len(parts) == 2:
+			names = append(names, key)
+		case parts[0] == "snapshot" && len(parts) == 3 && parts[2] != "current":
+			var record swingStoreSnapshotRecord
+			if json.Unmarshal([]byte(value), &record) == nil && record.InUse != 0 {
+				names = append(names, key)
+			}
+		case parts[0] == "transcript" && len(parts) == 3:
+			var record swingStoreTranscriptSpanRecord
+			if json.Unmarshal([]byte(value), &record) == nil && record.IsCurrent != 0 {
+				names = append(names, fmt.Sprintf("transcript.%s.%d.%d", record.VatID, record.StartPos, record.EndPos))
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
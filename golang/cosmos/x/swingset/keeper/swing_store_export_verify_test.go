@@ -0,0 +1,196 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+// writeTestSwingStoreExport writes a swing-store export with a bundle, a heap
+// snapshot and a transcript span, returning the export directory.
+func writeTestSwingStoreExport(t *testing.T, blockHeight uint64, tamper func(artifacts []types.SwingStoreArtifact, exportData []*types.SwingStoreExportDataEntry)) string {
+	snapshot := []byte("heap snapshot")
+	items := []string{`["create-vat"]`, `["deliver",1]`, `["deliver",2]`}
+	spanHash := transcriptSpanInitialHash
+	for _, item := range items {
+		spanHash = updateTranscriptSpanHash(spanHash, item)
+	}
+	spanRecord, err := json.Marshal(swingStoreTranscriptSpanRecord{VatID: "v1", StartPos: 5, EndPos: 8, Hash: spanHash, IsCurrent: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	snapshotRecord := fmt.Sprintf(`{"vatID":"v1","snapPos":4,"hash":%q,"inUse":1}`, sha256Hex(snapshot))
+
+	exportData := []*types.SwingStoreExportDataEntry{
+		{Key: "bundle.b1-abc", Value: "b1-abc"},
+		{Key: "snapshot.v1.4", Value: snapshotRecord},
+		{Key: "snapshot.v1.current", Value: "snapshot.v1.4"},
+		{Key: "transcript.v1.current", Value: string(spanRecord)},
+	}
+	artifacts := []types.SwingStoreArtifact{
+		{Name: "bundle.b1-abc", Data: []byte("zip")},
+		{Name: "snapshot.v1.4", Data: snapshot},
+		{Name: "transcript.v1.5.8", Data: []byte(strings.Join(items, "\n") + "\n")},
+	}
+	if tamper != nil {
+		tamper(artifacts, exportData)
+	}
+
+	exportDir := t.TempDir()
+	nextArtifact := 0
+	provider := SwingStoreExportProvider{
+		BlockHeight: blockHeight,
+		GetExportDataReader: func() (agoric.KVEntryReader, error) {
+			return agoric.NewSwingStoreExportDataEntriesReader(exportData), nil
+		},
+		ReadNextArtifact: func() (types.SwingStoreArtifact, error) {
+			if nextArtifact == len(artifacts) {
+				return types.SwingStoreArtifact{}, io.EOF
+			}
+			nextArtifact++
+			return artifacts[nextArtifact-1], nil
+		},
+	}
+	if err := WriteSwingStoreExportToDirectory(provider, exportDir); err != nil {
+		t.Fatal(err)
+	}
+	return exportDir
+}
+
+func TestVerifySwingStoreExportDirectory(t *testing.T) {
+	exportDir := writeTestSwingStoreExport(t, 42, nil)
+	report, err := VerifySwingStoreExportDirectory(exportDir, SwingStoreExportVerifyOptions{BlockHeight: 42})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.OK() {
+		t.Fatalf("unexpected problems %q", report.Problems)
+	}
+	if report.BlockHeight != 42 || report.ExportDataEntries != 4 || report.Artifacts != 3 || report.VerifiedArtifacts != 2 {
+		t.Errorf("got report %+v", report)
+	}
+	if len(report.UnverifiedArtifacts) != 1 || report.UnverifiedArtifacts[0] != "bundle.b1-abc" {
+		t.Errorf("got unverified artifacts %q", report.UnverifiedArtifacts)
+	}
+
+	// The export data hash is checked.
+	report, err = VerifySwingStoreExportDirectory(exportDir, SwingStoreExportVerifyOptions{ExportDataHash: report.ExportDataHash})
+	if err != nil || !report.OK() {
+		t.Errorf("got %v, problems %q", err, report.Problems)
+	}
+	report, err = VerifySwingStoreExportDirectory(exportDir, SwingStoreExportVerifyOptions{ExportDataHash: "sha256:00"})
+	if err != nil || len(report.Problems) != 1 {
+		t.Errorf("got %v, problems %q", err, report.Problems)
+	}
+}
+
+func TestVerifySwingStoreExportDirectoryProblems(t *testing.T) {
+	for _, tt := range []struct {
+		name        string
+		blockHeight uint64
+		tamper      func(artifacts []types.SwingStoreArtifact, exportData []*types.SwingStoreExportDataEntry)
+		modify      func(exportDir string) error
+		problem     string
+	}{
+		{
+			name:        "wrong height",
+			blockHeight: 41,
+			problem:     "manifest block height 41 does not match expected 42",
+		},
+		{
+			name: "corrupt snapshot",
+			tamper: func(artifacts []types.SwingStoreArtifact, _ []*types.SwingStoreExportDataEntry) {
+				artifacts[1].Data = []byte("corrupt")
+			},
+			problem: "artifact snapshot.v1.4 (1-snapshot.v1.4): hash",
+		},
+		{
+			name: "truncated transcript",
+			tamper: func(artifacts []types.SwingStoreArtifact, _ []*types.SwingStoreExportDataEntry) {
+				artifacts[2].Data = artifacts[2].Data[:len(artifacts[2].Data)-15]
+			},
+			problem: "artifact transcript.v1.5.8 (2-transcript.v1.5.8): has 2 items instead of 3",
+		},
+		{
+			name: "altered transcript",
+			tamper: func(artifacts []types.SwingStoreArtifact, _ []*types.SwingStoreExportDataEntry) {
+				artifacts[2].Data = []byte(strings.Replace(string(artifacts[2].Data), "2", "3", 1))
+			},
+			problem: "artifact transcript.v1.5.8 (2-transcript.v1.5.8): hash",
+		},
+		{
+			name: "missing bundle record",
+			tamper: func(_ []types.SwingStoreArtifact, exportData []*types.SwingStoreExportDataEntry) {
+				exportData[0].Key = "bundle.b1-def"
+				exportData[0].Value = "b1-def"
+			},
+			problem: "artifact bundle.b1-abc (0-bundle.b1-abc): no export data entry",
+		},
+		{
+			name: "missing artifact file",
+			modify: func(exportDir string) error {
+				return os.Remove(filepath.Join(exportDir, "1-snapshot.v1.4"))
+			},
+			problem: "artifact snapshot.v1.4 (1-snapshot.v1.4): open",
+		},
+		{
+			name: "unlisted artifact",
+			modify: func(exportDir string) error {
+				manifestPath := filepath.Join(exportDir, ExportManifestFilename)
+				rawManifest, err := os.ReadFile(manifestPath)
+				if err != nil {
+					return err
+				}
+				var manifest exportManifest
+				if err := json.Unmarshal(rawManifest, &manifest); err != nil {
+					return err
+				}
+				manifest.Artifacts = manifest.Artifacts[:2]
+				rawManifest, err = json.Marshal(manifest)
+				if err != nil {
+					return err
+				}
+				return os.WriteFile(manifestPath, rawManifest, exportedFilesMode)
+			},
+			problem: "artifact transcript.v1.5.8 required by the export data is missing",
+		},
+		{
+			name: "corrupt export data",
+			modify: func(exportDir string) error {
+				return os.WriteFile(filepath.Join(exportDir, exportDataFilename), []byte("[\"bundle.b1-abc\""), exportedFilesMode)
+			},
+			problem: "export data entry 1:",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			blockHeight := tt.blockHeight
+			if blockHeight == 0 {
+				blockHeight = 42
+			}
+			exportDir := writeTestSwingStoreExport(t, blockHeight, tt.tamper)
+			if tt.modify != nil {
+				if err := tt.modify(exportDir); err != nil {
+					t.Fatal(err)
+				}
+			}
+			report, err := VerifySwingStoreExportDirectory(exportDir, SwingStoreExportVerifyOptions{BlockHeight: 42})
+			if err != nil {
+				t.Fatal(err)
+			}
+			found := false
+			for _, problem := range report.Problems {
+				found = found || strings.HasPrefix(problem, tt.problem)
+			}
+			if !found {
+				t.Errorf("got problems %q, want %q", report.Problems, tt.problem)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -91,6 +92,12 @@ type exportManifest struct {
 	Data string `json:"data,omitempty"`
 	// Artifacts is the list of [artifact name, file name] pairs.
 	Artifacts [][2]string `json:"artifacts"`
+	// Parent identifies the export an incremental export builds on, and is
+	// unset for a full export.
+	Parent *exportManifestParent `json:"parent,omitempty"`
+	// ParentArtifacts is the list of artifact names of an incremental export
+	// which are not included because they are part of the parent export chain.
+	ParentArtifacts []string `json:"parentArtifacts,omitempty"`
 }
 
 // ExportManifestFilename is the manifest filename which must be synchronized with the JS export/import tooling
@@ -207,6 +214,11 @@ type SwingStoreExportOptions struct {
 	// SwingStoreExportDataModeAll. If "skip", the reader returned by
 	// SwingStoreExportProvider's GetExportDataReader will be nil.
 	ExportDataMode string `json:"exportDataMode,omitempty"`
+	// ParentExportDir is the directory of a previous swing-store export, saved
+	// by WriteSwingStoreExportToDirectory, whose artifacts the JS swing-store
+	// does not export again. The provider lists them in ParentArtifacts, and
+	// must be saved with WriteIncrementalSwingStoreExportToDirectory.
+	ParentExportDir string `json:"parentExportDir,omitempty"`
 }
 
 // SwingStoreRestoreOptions are configurable options provided to the JS swing-store import
@@ -224,6 +236,26 @@ type SwingStoreRestoreOptions struct {
 	ExportDataMode string `json:"exportDataMode,omitempty"`
 }
 
+// ValidateSwingStoreRestoreOptions checks that the restore options are a
+// combination of modes accepted by the JS swing-store import.
+func ValidateSwingStoreRestoreOptions(restoreOptions SwingStoreRestoreOptions) error {
+	switch restoreOptions.ExportDataMode {
+	case SwingStoreExportDataModeAll:
+		switch restoreOptions.ArtifactMode {
+		case SwingStoreArtifactModeOperational, SwingStoreArtifactModeReplay,
+			SwingStoreArtifactModeArchival, SwingStoreArtifactModeDebug:
+			return nil
+		}
+	case SwingStoreExportDataModeRepairMetadata:
+		if restoreOptions.ArtifactMode == SwingStoreArtifactModeNone {
+			return nil
+		}
+	default:
+		return fmt.Errorf("invalid swing-store export data mode %q", restoreOptions.ExportDataMode)
+	}
+	return fmt.Errorf("invalid swing-store artifact mode %q for export data mode %q", restoreOptions.ArtifactMode, restoreOptions.ExportDataMode)
+}
+
 type swingStoreImportOptions struct {
 	// ExportDir is the directory created by RestoreExport that JS swing-store
 	// should import from.
@@ -407,6 +439,29 @@ type SwingStoreExportProvider struct {
 	// ReadNextArtifact is a function to return the next unread artifact in the SwingStore export.
 	// It errors with io.EOF upon reaching the end of the list of available artifacts.
 	ReadNextArtifact func() (types.SwingStoreArtifact, error)
+	// OpenNextArtifact is an optional alternative to ReadNextArtifact, returning
+	// the name of the next unread artifact and a reader of its data, so that
+	// large artifacts do not need to be held in memory. The reader must be
+	// closed before opening the next artifact.
+	// It errors with io.EOF upon reaching the end of the list of available artifacts.
+	OpenNextArtifact func() (string, io.ReadCloser, error)
+	// ParentArtifacts is the list of names of the artifacts of the SwingStore
+	// export that are not provided because they are part of the parent export
+	// given by SwingStoreExportOptions.ParentExportDir.
+	ParentArtifacts []string
+}
+
+// openNextArtifact returns the name of the next unread artifact of the
+// provider and a reader of its data, using OpenNextArtifact if available.
+func (provider SwingStoreExportProvider) openNextArtifact() (string, io.ReadCloser, error) {
+	if provider.OpenNextArtifact != nil {
+		return provider.OpenNextArtifact()
+	}
+	artifact, err := provider.ReadNextArtifact()
+	if err != nil {
+		return "", nil, err
+	}
+	return artifact.Name, io.NopCloser(bytes.NewReader(artifact.Data)), nil
 }
 
 // SwingStoreExportEventHandler is used to handle events that occur while generating
@@ -675,6 +730,9 @@ func OpenSwingStoreExportDirectory(exportDir string) (SwingStoreExportProvider,
 	if err != nil {
 		return SwingStoreExportProvider{}, err
 	}
+	if manifest.Parent != nil {
+		return SwingStoreExportProvider{}, fmt.Errorf("swing-store export at height %d is incremental and must be merged with its parents first", manifest.BlockHeight)
+	}
 
 	getExportDataReader := func() (agoric.KVEntryReader, error) {
 		if manifest.Data == "" {
@@ -691,20 +749,30 @@ func OpenSwingStoreExportDirectory(exportDir string) (SwingStoreExportProvider,
 
 	nextArtifact := 0
 
-	readNextArtifact := func() (artifact types.SwingStoreArtifact, err error) {
+	// nextArtifactEntry returns the next manifest entry, shared by
+	// readNextArtifact and openNextArtifact.
+	nextArtifactEntry := func() (artifactName string, fileName string, err error) {
 		if nextArtifact == len(manifest.Artifacts) {
-			return artifact, io.EOF
+			return "", "", io.EOF
 		} else if nextArtifact > len(manifest.Artifacts) {
-			return artifact, fmt.Errorf("exceeded expected artifact count: %d > %d", nextArtifact, len(manifest.Artifacts))
+			return "", "", fmt.Errorf("exceeded expected artifact count: %d > %d", nextArtifact, len(manifest.Artifacts))
 		}
 
 		artifactEntry := manifest.Artifacts[nextArtifact]
 		nextArtifact++
 
-		artifactName := artifactEntry[0]
-		fileName := artifactEntry[1]
+		artifactName = artifactEntry[0]
+		fileName = artifactEntry[1]
 		if artifactName == UntrustedExportDataArtifactName {
-			return artifact, fmt.Errorf("unexpected export artifact name %s", artifactName)
+			return "", "", fmt.Errorf("unexpected export artifact name %s", artifactName)
+		}
+		return artifactName, fileName, nil
+	}
+
+	readNextArtifact := func() (artifact types.SwingStoreArtifact, err error) {
+		artifactName, fileName, err := nextArtifactEntry()
+		if err != nil {
+			return artifact, err
 		}
 		artifact.Name = artifactName
 		artifact.Data, err = os.ReadFile(filepath.Join(exportDir, fileName))
@@ -712,7 +780,25 @@ func OpenSwingStoreExportDirectory(exportDir string) (SwingStoreExportProvider,
 		return artifact, err
 	}
 
-	return SwingStoreExportProvider{BlockHeight: manifest.BlockHeight, GetExportDataReader: getExportDataReader, ReadNextArtifact: readNextArtifact}, nil
+	openNextArtifact := func() (string, io.ReadCloser, error) {
+		artifactName, fileName, err := nextArtifactEntry()
+		if err != nil {
+			return "", nil, err
+		}
+		artifactFile, err := os.Open(filepath.Join(exportDir, fileName))
+		if err != nil {
+			return "", nil, err
+		}
+		return artifactName, artifactFile, nil
+	}
+
+	return SwingStoreExportProvider{
+		BlockHeight:         manifest.BlockHeight,
+		GetExportDataReader: getExportDataReader,
+		ReadNextArtifact:    readNextArtifact,
+		OpenNextArtifact:    openNextArtifact,
+		ParentArtifacts:     manifest.ParentArtifacts,
+	}, nil
 }
 
 // RestoreExport restores the JS swing-store using previously exported data and artifacts.
@@ -787,7 +873,13 @@ func (exportsHandler SwingStoreExportsHandler) RestoreExport(provider SwingStore
 // a jsonl-like file, before saving the export manifest linking these together.
 // The export manifest filename and overall export format is common with the JS
 // swing-store import/export logic.
-func WriteSwingStoreExportToDirectory(provider SwingStoreExportProvider, exportDir string) (err error) {
+func WriteSwingStoreExportToDirectory(provider SwingStoreExportProvider, exportDir string) error {
+	return writeSwingStoreExportToDirectory(provider, exportDir, nil)
+}
+
+// writeSwingStoreExportToDirectory writes a full export, or an incremental
+// export if a parent is provided.
+func writeSwingStoreExportToDirectory(provider SwingStoreExportProvider, exportDir string, parent *incrementalExportParent) (err error) {
 	handleDeferError := func(fn func() error) {
 		deferError := fn()
 		if err == nil {
@@ -802,6 +894,16 @@ func WriteSwingStoreExportToDirectory(provider SwingStoreExportProvider, exportD
 	manifest := exportManifest{
 		BlockHeight: provider.BlockHeight,
 	}
+	if parent != nil {
+		manifest.Parent = &parent.exportManifestParent
+		manifest.ParentArtifacts = []string{}
+	}
+	for _, artifactName := range provider.ParentArtifacts {
+		if parent == nil || !parent.artifactNames[artifactName] {
+			return fmt.Errorf("swing-store export artifact %s is missing from its parent export", artifactName)
+		}
+		manifest.ParentArtifacts = append(manifest.ParentArtifacts, artifactName)
+	}
 
 	exportDataReader, err := provider.GetExportDataReader()
 	if err != nil {
@@ -833,15 +935,31 @@ func WriteSwingStoreExportToDirectory(provider SwingStoreExportProvider, exportD
 		return os.WriteFile(filepath.Join(exportDir, filename), data, exportedFilesMode)
 	}
 
+	copyToExportFile := func(filename string, dataReader io.Reader) error {
+		exportFile, err := os.OpenFile(filepath.Join(exportDir, filename), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, exportedFilesMode)
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(exportFile, dataReader)
+		if closeErr := exportFile.Close(); err == nil {
+			err = closeErr
+		}
+		return err
+	}
+
 	for {
-		artifact, err := provider.ReadNextArtifact()
+		artifactName, artifactReader, err := provider.openNextArtifact()
 		if err == io.EOF {
 			break
 		} else if err != nil {
 			return err
 		}
 
-		if artifact.Name != UntrustedExportDataArtifactName {
+		if parent != nil && parent.artifactNames[artifactName] {
+			// Artifact names identify immutable content in swing-store, so an
+			// artifact known to the parent chain is unchanged.
+			manifest.ParentArtifacts = append(manifest.ParentArtifacts, artifactName)
+		} else if artifactName != UntrustedExportDataArtifactName {
 			// An artifact is only verifiable by the JS swing-store import using the
 			// information contained in the "export data".
 			// Since we cannot trust the source of the artifact at this point,
@@ -850,14 +968,17 @@ func WriteSwingStoreExportToDirectory(provider SwingStoreExportProvider, exportD
 			// any non letters-digits-hyphen-underscore-dot by a hyphen, and
 			// prefixing with an incremented id.
 			// The filename is not used for any purpose in the import logic.
-			filename := sanitizeArtifactName(artifact.Name)
+			filename := sanitizeArtifactName(artifactName)
 			filename = fmt.Sprintf("%d-%s", len(manifest.Artifacts), filename)
-			manifest.Artifacts = append(manifest.Artifacts, [2]string{artifact.Name, filename})
-			err = writeExportFile(filename, artifact.Data)
+			manifest.Artifacts = append(manifest.Artifacts, [2]string{artifactName, filename})
+			err = copyToExportFile(filename, artifactReader)
 		} else {
 			// Pseudo artifact containing untrusted export data which may have been
 			// saved separately for debugging purposes (not referenced from the manifest)
-			err = writeExportFile(untrustedExportDataFilename, artifact.Data)
+			err = copyToExportFile(untrustedExportDataFilename, artifactReader)
+		}
+		if closeErr := artifactReader.Close(); err == nil {
+			err = closeErr
 		}
 		if err != nil {
 			return err
@@ -75,22 +75,26 @@ func (AppModuleBasic) GetTxCmd() *cobra.Command {
 
 type AppModule struct {
 	AppModuleBasic
-	keeper                   Keeper
-	swingStoreExportsHandler *SwingStoreExportsHandler
-	setBootstrapNeeded       func()
-	ensureControllerInited   func(sdk.Context)
-	swingStoreExportDir      string
+	keeper                    Keeper
+	swingStoreExportsHandler  *SwingStoreExportsHandler
+	setBootstrapNeeded        func()
+	ensureControllerInited    func(sdk.Context)
+	swingStoreExportDir       string
+	swingStoreArtifactMode    string
+	swingStoreExportParentDir string
 }
 
 // NewAppModule creates a new AppModule Object
-func NewAppModule(k Keeper, swingStoreExportsHandler *SwingStoreExportsHandler, setBootstrapNeeded func(), ensureControllerInited func(sdk.Context), swingStoreExportDir string) AppModule {
+func NewAppModule(k Keeper, swingStoreExportsHandler *SwingStoreExportsHandler, setBootstrapNeeded func(), ensureControllerInited func(sdk.Context), swingStoreExportDir string, swingStoreArtifactMode string, swingStoreExportParentDir string) AppModule {
 	am := AppModule{
-		AppModuleBasic:           AppModuleBasic{},
-		keeper:                   k,
-		swingStoreExportsHandler: swingStoreExportsHandler,
-		setBootstrapNeeded:       setBootstrapNeeded,
-		ensureControllerInited:   ensureControllerInited,
-		swingStoreExportDir:      swingStoreExportDir,
+		AppModuleBasic:            AppModuleBasic{},
+		keeper:                    k,
+		swingStoreExportsHandler:  swingStoreExportsHandler,
+		setBootstrapNeeded:        setBootstrapNeeded,
+		ensureControllerInited:    ensureControllerInited,
+		swingStoreExportDir:       swingStoreExportDir,
+		swingStoreArtifactMode:    swingStoreArtifactMode,
+		swingStoreExportParentDir: swingStoreExportParentDir,
 	}
 	return am
 }
@@ -158,13 +162,16 @@ func (am *AppModule) checkSwingStoreExportSetup() {
 	if am.swingStoreExportDir == "" {
 		am.swingStoreExportDir = "/tmp/swingset_export"
 	}
+	if am.swingStoreArtifactMode == "" {
+		am.swingStoreArtifactMode = keeper.SwingStoreArtifactModeOperational
+	}
 }
 
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
 	var genesisState types.GenesisState
 	cdc.MustUnmarshalJSON(data, &genesisState)
 	am.checkSwingStoreExportSetup()
-	bootstrapNeeded := InitGenesis(ctx, am.keeper, am.swingStoreExportsHandler, am.swingStoreExportDir, &genesisState)
+	bootstrapNeeded := InitGenesis(ctx, am.keeper, am.swingStoreExportsHandler, am.swingStoreExportDir, am.swingStoreArtifactMode, &genesisState)
 	if bootstrapNeeded {
 		am.setBootstrapNeeded()
 	}
@@ -173,6 +180,6 @@ func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.
 
 func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
 	am.checkSwingStoreExportSetup()
-	gs := ExportGenesis(ctx, am.keeper, am.swingStoreExportsHandler, am.swingStoreExportDir)
+	gs := ExportGenesis(ctx, am.keeper, am.swingStoreExportsHandler, am.swingStoreExportDir, am.swingStoreExportParentDir)
 	return cdc.MustMarshalJSON(gs)
 }
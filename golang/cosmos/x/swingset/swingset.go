@@ -24,8 +24,18 @@ type swingsetMessage struct {
 
 const (
 	SwingStoreUpdateExportData = "swingStoreUpdateExportData"
+	BundleInstalled            = "bundleInstalled"
 )
 
+// bundleInstalledArgs is the argument of a BundleInstalled message, which the
+// controller sends once it has installed a bundle submitted by
+// MsgInstallBundle.
+type bundleInstalledArgs struct {
+	ID        string `json:"id"`
+	Submitter string `json:"submitter"`
+	Size      int64  `json:"size"`
+}
+
 // NewPortHandler returns a port handler for a swingset Keeper.
 func NewPortHandler(k Keeper) vm.PortHandler {
 	return portHandler{keeper: k}
@@ -46,6 +56,9 @@ func (ph portHandler) Receive(cctx context.Context, str string) (string, error)
 	case SwingStoreUpdateExportData:
 		return ph.handleSwingStoreUpdateExportData(ctx, msg.Args)
 
+	case BundleInstalled:
+		return ph.handleBundleInstalled(ctx, msg.Args)
+
 	default:
 		return "", fmt.Errorf("unrecognized swingset method %s", msg.Method)
 	}
@@ -71,3 +84,23 @@ func (ph portHandler) handleSwingStoreUpdateExportData(ctx sdk.Context, entries
 		}
 	}
 }
+
+func (ph portHandler) handleBundleInstalled(ctx sdk.Context, args []json.RawMessage) (string, error) {
+	if len(args) != 1 {
+		return "", fmt.Errorf("expected 1 argument to %s, got %d", BundleInstalled, len(args))
+	}
+	var installed bundleInstalledArgs
+	err := json.Unmarshal(args[0], &installed)
+	if err != nil {
+		return "", err
+	}
+	if installed.ID == "" {
+		return "", fmt.Errorf("missing bundle ID")
+	}
+	submitter, err := sdk.AccAddressFromBech32(installed.Submitter)
+	if err != nil {
+		return "", err
+	}
+	ph.keeper.RecordInstalledBundle(ctx, installed.ID, submitter, installed.Size)
+	return "true", nil
+}
@@ -33,6 +33,13 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgProvision{}, ModuleName+"/Provision", nil)
 	cdc.RegisterConcrete(&MsgWalletAction{}, ModuleName+"/WalletAction", nil)
 	cdc.RegisterConcrete(&MsgWalletSpendAction{}, ModuleName+"/WalletSpendAction", nil)
+	cdc.RegisterConcrete(&MsgWalletActionBatch{}, ModuleName+"/WalletActionBatch", nil)
+	cdc.RegisterConcrete(&MsgScheduleAction{}, ModuleName+"/ScheduleAction", nil)
+	cdc.RegisterConcrete(&MsgCancelScheduledAction{}, ModuleName+"/CancelScheduledAction", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, ModuleName+"/UpdateParams", nil)
+	cdc.RegisterConcrete(&MsgBeginBundleUpload{}, ModuleName+"/BeginBundleUpload", nil)
+	cdc.RegisterConcrete(&MsgSendBundleChunk{}, ModuleName+"/SendBundleChunk", nil)
+	cdc.RegisterConcrete(&MsgFinalizeBundleUpload{}, ModuleName+"/FinalizeBundleUpload", nil)
 }
 
 // RegisterInterfaces registers the x/swingset interfaces types with the interface registry
@@ -42,6 +49,13 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgProvision{},
 		&MsgWalletAction{},
 		&MsgWalletSpendAction{},
+		&MsgWalletActionBatch{},
+		&MsgScheduleAction{},
+		&MsgCancelScheduledAction{},
+		&MsgUpdateParams{},
+		&MsgBeginBundleUpload{},
+		&MsgSendBundleChunk{},
+		&MsgFinalizeBundleUpload{},
 	)
 	registry.RegisterImplementations(
 		(*govv1beta1.Content)(nil),
@@ -27,6 +27,14 @@ const (
 	QueueInbound        = "inbound"
 	QueueInboundMempool = "inbound_mempool"
 
+	// QueueInboundMsgTypePrefix followed by a Msg type URL (such as
+	// "inbound_msg:/agoric.swingset.MsgDeliverInbound") is the key of the
+	// number of inbound messages of that type admitted per block.
+	QueueInboundMsgTypePrefix = "inbound_msg:"
+	// QueueInboundSender is the key of the number of inbound messages admitted
+	// per block from any one sender.
+	QueueInboundSender = "inbound_sender"
+
 	// PowerFlags.
 	PowerFlagSmartWallet = "SMART_WALLET"
 )
@@ -66,6 +74,17 @@ var (
 	DefaultQueueMax = []QueueSize{
 		NewQueueSize(QueueInbound, DefaultInboundQueueMax),
 	}
+
+	// Congestion pricing is disabled by default.  When enabled, these values
+	// hold the price steady at half the inbound queue maximum, with the price
+	// changing by at most 12% per block between $0.01 and $1.
+	DefaultInboundPricing = InboundPricing{
+		Enabled:            false,
+		TargetQueuePercent: 50,
+		MaxChangePercent:   12,
+		MinBeans:           DefaultBeansPerInboundTx,
+		MaxBeans:           DefaultBeansPerFeeUnit,
+	}
 )
 
 // move DefaultBeansPerUnit to a function to allow for boot overriding of the Default params
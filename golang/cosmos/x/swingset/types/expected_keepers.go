@@ -15,6 +15,16 @@ const (
 	SmartWalletStateProvisioned
 )
 
+// Charge types recorded in the charge history of an account.
+const (
+	ChargeTypeInboundAdmission     = "inboundAdmission"
+	ChargeTypeSmartWalletProvision = "smartWalletProvision"
+	ChargeTypeProvisionPowerFlags  = "provisionPowerFlags"
+	ChargeTypeScheduledAction      = "scheduledAction"
+	ChargeTypeBundleUploadBegin    = "bundleUploadBegin"
+	ChargeTypeBundleChunk          = "bundleChunk"
+)
+
 type AccountKeeper interface {
 	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
 	NewAccountWithAddress(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
@@ -23,8 +33,9 @@ type AccountKeeper interface {
 
 type SwingSetKeeper interface {
 	GetBeansPerUnit(ctx sdk.Context) map[string]sdkmath.Uint
-	ChargeBeans(ctx sdk.Context, addr sdk.AccAddress, beans sdkmath.Uint) error
+	ChargeBeans(ctx sdk.Context, addr sdk.AccAddress, beans sdkmath.Uint, chargeType string) error
 	IsHighPriorityAddress(ctx sdk.Context, addr sdk.AccAddress) (bool, error)
 	GetSmartWalletState(ctx sdk.Context, addr sdk.AccAddress) SmartWalletState
 	ChargeForSmartWallet(ctx sdk.Context, addr sdk.AccAddress) error
+	GetBundleUpload(ctx sdk.Context, id uint64) (BundleUpload, bool)
 }
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -29,6 +30,12 @@ type GenesisState struct {
 	State                    State                        `protobuf:"bytes,3,opt,name=state,proto3" json:"state"`
 	SwingStoreExportData     []*SwingStoreExportDataEntry `protobuf:"bytes,4,rep,name=swing_store_export_data,json=swingStoreExportData,proto3" json:"swingStoreExportData"`
 	SwingStoreExportDataHash string                       `protobuf:"bytes,5,opt,name=swing_store_export_data_hash,json=swingStoreExportDataHash,proto3" json:"swingStoreExportDataHash"`
+	// The retained charge history of each account.
+	ChargeHistory []AccountChargeHistory `protobuf:"bytes,6,rep,name=charge_history,json=chargeHistory,proto3" json:"chargeHistory,omitempty"`
+	// The scheduled actions that have not yet been delivered.
+	ScheduledActions []ScheduledAction `protobuf:"bytes,7,rep,name=scheduled_actions,json=scheduledActions,proto3" json:"scheduledActions,omitempty"`
+	// The index of installed bundles.
+	InstalledBundles []InstalledBundle `protobuf:"bytes,8,rep,name=installed_bundles,json=installedBundles,proto3" json:"installedBundles,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -92,6 +99,80 @@ func (m *GenesisState) GetSwingStoreExportDataHash() string {
 	return ""
 }
 
+func (m *GenesisState) GetChargeHistory() []AccountChargeHistory {
+	if m != nil {
+		return m.ChargeHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetScheduledActions() []ScheduledAction {
+	if m != nil {
+		return m.ScheduledActions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetInstalledBundles() []InstalledBundle {
+	if m != nil {
+		return m.InstalledBundles
+	}
+	return nil
+}
+
+// The retained charges to an account, oldest first.
+type AccountChargeHistory struct {
+	Address github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address" yaml:"address"`
+	Charges []ChargeRecord                                `protobuf:"bytes,2,rep,name=charges,proto3" json:"charges" yaml:"charges"`
+}
+
+func (m *AccountChargeHistory) Reset()         { *m = AccountChargeHistory{} }
+func (m *AccountChargeHistory) String() string { return proto.CompactTextString(m) }
+func (*AccountChargeHistory) ProtoMessage()    {}
+func (*AccountChargeHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49b057311de9d296, []int{1}
+}
+func (m *AccountChargeHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountChargeHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountChargeHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountChargeHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountChargeHistory.Merge(m, src)
+}
+func (m *AccountChargeHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountChargeHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountChargeHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountChargeHistory proto.InternalMessageInfo
+
+func (m *AccountChargeHistory) GetAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *AccountChargeHistory) GetCharges() []ChargeRecord {
+	if m != nil {
+		return m.Charges
+	}
+	return nil
+}
+
 // A SwingStore "export data" entry.
 type SwingStoreExportDataEntry struct {
 	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
@@ -102,7 +183,7 @@ func (m *SwingStoreExportDataEntry) Reset()         { *m = SwingStoreExportDataE
 func (m *SwingStoreExportDataEntry) String() string { return proto.CompactTextString(m) }
 func (*SwingStoreExportDataEntry) ProtoMessage()    {}
 func (*SwingStoreExportDataEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_49b057311de9d296, []int{1}
+	return fileDescriptor_49b057311de9d296, []int{2}
 }
 func (m *SwingStoreExportDataEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -147,36 +228,51 @@ func (m *SwingStoreExportDataEntry) GetValue() string {
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "agoric.swingset.GenesisState")
+	proto.RegisterType((*AccountChargeHistory)(nil), "agoric.swingset.AccountChargeHistory")
 	proto.RegisterType((*SwingStoreExportDataEntry)(nil), "agoric.swingset.SwingStoreExportDataEntry")
 }
 
 func init() { proto.RegisterFile("agoric/swingset/genesis.proto", fileDescriptor_49b057311de9d296) }
 
 var fileDescriptor_49b057311de9d296 = []byte{
-	// 363 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcd, 0x4a, 0xc3, 0x40,
-	0x14, 0x85, 0x93, 0xfe, 0x41, 0xa7, 0x82, 0x12, 0x8a, 0x8d, 0xa5, 0x26, 0xa5, 0xab, 0x22, 0x98,
-	0x40, 0xc5, 0x8d, 0xae, 0x8c, 0x16, 0x5d, 0x4a, 0x8a, 0x1b, 0x11, 0xc2, 0xb4, 0x1d, 0x26, 0xa1,
-	0x6d, 0x26, 0xe4, 0x4e, 0xb5, 0xc5, 0x97, 0xf0, 0x11, 0x7c, 0x9c, 0x2e, 0xbb, 0x14, 0x17, 0x45,
-	0xda, 0x8d, 0xf4, 0x29, 0x24, 0x33, 0x2d, 0x42, 0x7f, 0x76, 0x27, 0xf9, 0xce, 0x39, 0x37, 0xdc,
-	0x1b, 0x74, 0x8a, 0x29, 0x8b, 0x83, 0x8e, 0x0d, 0x6f, 0x41, 0x48, 0x81, 0x70, 0x9b, 0x92, 0x90,
-	0x40, 0x00, 0x56, 0x14, 0x33, 0xce, 0xb4, 0x43, 0x89, 0xad, 0x35, 0x2e, 0x17, 0x29, 0xa3, 0x4c,
-	0x30, 0x3b, 0x51, 0xd2, 0x56, 0x36, 0x36, 0x5b, 0xd6, 0x42, 0xf2, 0xda, 0x77, 0x0a, 0x1d, 0xdc,
-	0xcb, 0xe2, 0x16, 0xc7, 0x9c, 0x68, 0x97, 0x28, 0x17, 0xe1, 0x18, 0x0f, 0x40, 0x4f, 0x55, 0xd5,
-	0x7a, 0xa1, 0x51, 0xb2, 0x36, 0x06, 0x59, 0x8f, 0x02, 0x3b, 0x99, 0xc9, 0xcc, 0x54, 0xdc, 0x95,
-	0x59, 0x6b, 0xa0, 0x2c, 0x24, 0x79, 0x3d, 0x2d, 0x52, 0xc7, 0x5b, 0x29, 0xd1, 0xbe, 0x0a, 0x49,
-	0xab, 0xf6, 0x8e, 0x4a, 0x02, 0x7b, 0xc0, 0x59, 0x4c, 0x3c, 0x32, 0x8a, 0x58, 0xcc, 0xbd, 0x2e,
-	0xe6, 0x58, 0xcf, 0x54, 0xd3, 0xf5, 0x42, 0xe3, 0x6c, 0xbb, 0x25, 0x11, 0xad, 0xc4, 0xde, 0x14,
-	0xee, 0x3b, 0xcc, 0x71, 0x33, 0xe4, 0xf1, 0xd8, 0xd1, 0x97, 0x33, 0xb3, 0x08, 0x3b, 0xb0, 0xbb,
-	0xf3, 0xad, 0xf6, 0x82, 0x2a, 0x7b, 0x86, 0x7b, 0x3e, 0x06, 0x5f, 0xcf, 0x56, 0xd5, 0x7a, 0xde,
-	0xa9, 0x2c, 0x67, 0xa6, 0xbe, 0x2b, 0xff, 0x80, 0xc1, 0x77, 0xf7, 0x92, 0xab, 0xcc, 0xef, 0xa7,
-	0xa9, 0xd4, 0x6e, 0xd1, 0xc9, 0xde, 0x0f, 0xd6, 0x8e, 0x50, 0xba, 0x47, 0xc6, 0xba, 0x9a, 0xcc,
-	0x71, 0x13, 0xa9, 0x15, 0x51, 0xf6, 0x15, 0xf7, 0x87, 0x44, 0x6c, 0x3e, 0xef, 0xca, 0x07, 0xe7,
-	0x69, 0x32, 0x37, 0xd4, 0xe9, 0xdc, 0x50, 0x7f, 0xe6, 0x86, 0xfa, 0xb1, 0x30, 0x94, 0xe9, 0xc2,
-	0x50, 0xbe, 0x16, 0x86, 0xf2, 0x7c, 0x4d, 0x03, 0xee, 0x0f, 0xdb, 0x56, 0x87, 0x0d, 0xec, 0x1b,
-	0x79, 0x66, 0xb9, 0xaf, 0x73, 0xe8, 0xf6, 0x6c, 0xca, 0xfa, 0x38, 0xa4, 0x76, 0x87, 0xc1, 0x80,
-	0x81, 0x3d, 0xfa, 0xff, 0x03, 0xf8, 0x38, 0x22, 0xd0, 0xce, 0x89, 0xfb, 0x5f, 0xfc, 0x05, 0x00,
-	0x00, 0xff, 0xff, 0x1b, 0x95, 0x41, 0xea, 0x67, 0x02, 0x00, 0x00,
+	// 581 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0x8d, 0xbf, 0xfc, 0x7d, 0x99, 0x96, 0x52, 0x46, 0x11, 0x31, 0x51, 0x6b, 0x07, 0x4b, 0x48,
+	0x11, 0xa2, 0x89, 0x14, 0xc4, 0xa6, 0xac, 0xe2, 0x52, 0x51, 0x36, 0x08, 0xb9, 0x62, 0x53, 0x21,
+	0x59, 0x13, 0x7b, 0x64, 0x5b, 0xb5, 0x3d, 0xc1, 0x33, 0x81, 0x5a, 0xbc, 0x04, 0x8f, 0xc0, 0x1b,
+	0xf0, 0x1a, 0x5d, 0x76, 0xc9, 0x02, 0x59, 0x28, 0xd9, 0xa0, 0x2c, 0x59, 0xb2, 0x42, 0x33, 0x63,
+	0x2b, 0xff, 0xab, 0xdc, 0xdc, 0x73, 0xce, 0x3d, 0x73, 0x6e, 0x32, 0x03, 0x8e, 0x91, 0x47, 0x92,
+	0xc0, 0xe9, 0xd3, 0xcf, 0x41, 0xec, 0x51, 0xcc, 0xfa, 0x1e, 0x8e, 0x31, 0x0d, 0x68, 0x6f, 0x9c,
+	0x10, 0x46, 0xe0, 0x7d, 0x09, 0xf7, 0x0a, 0xb8, 0xdd, 0xf4, 0x88, 0x47, 0x04, 0xd6, 0xe7, 0x95,
+	0xa4, 0xb5, 0xb5, 0xf5, 0x29, 0x45, 0x21, 0x71, 0xe3, 0x7b, 0x15, 0xec, 0xbf, 0x96, 0x83, 0x2f,
+	0x19, 0x62, 0x18, 0xbe, 0x00, 0xb5, 0x31, 0x4a, 0x50, 0x44, 0xd5, 0xff, 0x3a, 0x4a, 0x77, 0x6f,
+	0xd0, 0xea, 0xad, 0x19, 0xf5, 0xde, 0x09, 0xd8, 0xac, 0xdc, 0x66, 0x7a, 0xc9, 0xca, 0xc9, 0x70,
+	0x00, 0xaa, 0x94, 0xeb, 0xd5, 0xb2, 0x50, 0x3d, 0xdc, 0x50, 0x89, 0xe9, 0xb9, 0x48, 0x52, 0xe1,
+	0x17, 0xd0, 0x12, 0xb0, 0x4d, 0x19, 0x49, 0xb0, 0x8d, 0x6f, 0xc6, 0x24, 0x61, 0xb6, 0x8b, 0x18,
+	0x52, 0x2b, 0x9d, 0x72, 0x77, 0x6f, 0xf0, 0x74, 0x73, 0x0a, 0x2f, 0x2e, 0x39, 0xfd, 0x5c, 0xb0,
+	0x5f, 0x21, 0x86, 0xce, 0x63, 0x96, 0xa4, 0xa6, 0x3a, 0xcf, 0xf4, 0x26, 0xdd, 0x02, 0x5b, 0x5b,
+	0xbb, 0xf0, 0x03, 0x38, 0xda, 0x61, 0x6e, 0xfb, 0x88, 0xfa, 0x6a, 0xb5, 0xa3, 0x74, 0x1b, 0xe6,
+	0xd1, 0x3c, 0xd3, 0xd5, 0x6d, 0xfa, 0x0b, 0x44, 0x7d, 0x6b, 0x27, 0x02, 0x23, 0x70, 0xe0, 0xf8,
+	0x28, 0xf1, 0xb0, 0xed, 0x07, 0xdc, 0x20, 0x55, 0x6b, 0x22, 0xd1, 0x93, 0x8d, 0x44, 0x43, 0xc7,
+	0x21, 0x93, 0x98, 0x9d, 0x09, 0xf6, 0x85, 0x24, 0x9b, 0x3a, 0x5f, 0xd3, 0x3c, 0xd3, 0x5b, 0xce,
+	0x72, 0xfb, 0x19, 0x89, 0x02, 0x86, 0xa3, 0x31, 0x4b, 0xad, 0x7b, 0x2b, 0x00, 0xfc, 0x08, 0x1e,
+	0x50, 0xc7, 0xc7, 0xee, 0x24, 0xc4, 0xae, 0x8d, 0x1c, 0x16, 0x90, 0x98, 0xaa, 0x75, 0xe1, 0xd8,
+	0xd9, 0xdc, 0x61, 0xc1, 0x1c, 0x0a, 0xa2, 0x69, 0xe4, 0x66, 0x6d, 0xba, 0x0a, 0xd0, 0x25, 0xbf,
+	0xc3, 0x75, 0x8c, 0x5b, 0x06, 0x31, 0x65, 0x28, 0xe4, 0x96, 0xa3, 0x49, 0xec, 0x86, 0x98, 0xaa,
+	0xff, 0xef, 0xb0, 0x7c, 0x53, 0x30, 0x4d, 0x41, 0x5c, 0x58, 0x06, 0xab, 0xc0, 0x8a, 0xe5, 0x3a,
+	0x76, 0x5a, 0xf9, 0xfd, 0x4d, 0x2f, 0x19, 0x3f, 0x15, 0xd0, 0xdc, 0xb6, 0x34, 0xe8, 0x83, 0x3a,
+	0x72, 0xdd, 0x04, 0x53, 0xaa, 0x2a, 0x1d, 0xa5, 0xbb, 0x6f, 0xbe, 0x9d, 0x67, 0x7a, 0xd1, 0xfa,
+	0x93, 0xe9, 0x07, 0x29, 0x8a, 0xc2, 0x53, 0x23, 0x6f, 0x18, 0x7f, 0x33, 0xfd, 0xc4, 0x0b, 0x98,
+	0x3f, 0x19, 0xf5, 0x1c, 0x12, 0xf5, 0x1d, 0x42, 0x23, 0x42, 0xf3, 0x8f, 0x13, 0xea, 0x5e, 0xf7,
+	0x59, 0x3a, 0xc6, 0x94, 0xff, 0x3e, 0x43, 0xa9, 0xb0, 0x8a, 0x59, 0xf0, 0x0a, 0xd4, 0xe5, 0xfe,
+	0xf9, 0x25, 0xe1, 0x89, 0x8f, 0x37, 0x12, 0xcb, 0xa3, 0x59, 0xd8, 0x21, 0x89, 0x6b, 0x3e, 0xce,
+	0xe3, 0x16, 0xaa, 0xc5, 0x61, 0xf2, 0x86, 0x61, 0x15, 0x90, 0x71, 0x06, 0x1e, 0xed, 0xfc, 0x93,
+	0xc3, 0x43, 0x50, 0xbe, 0xc6, 0xa9, 0x88, 0xd7, 0xb0, 0x78, 0x09, 0x9b, 0xa0, 0xfa, 0x09, 0x85,
+	0x13, 0x2c, 0x6e, 0x6b, 0xc3, 0x92, 0x5f, 0xcc, 0xf7, 0xb7, 0x53, 0x4d, 0xb9, 0x9b, 0x6a, 0xca,
+	0xaf, 0xa9, 0xa6, 0x7c, 0x9d, 0x69, 0xa5, 0xbb, 0x99, 0x56, 0xfa, 0x31, 0xd3, 0x4a, 0x57, 0x2f,
+	0x97, 0x22, 0x0f, 0xe5, 0xd3, 0x20, 0x8f, 0x2e, 0x22, 0x7b, 0x24, 0x44, 0xb1, 0x57, 0xec, 0xe2,
+	0x66, 0xf1, 0x6a, 0x88, 0x5d, 0x8c, 0x6a, 0xe2, 0xcd, 0x78, 0xfe, 0x6f, 0x00, 0x39, 0x09, 0x0a,
+	0x0d, 0x9b, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -199,6 +295,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.InstalledBundles) > 0 {
+		for iNdEx := len(m.InstalledBundles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InstalledBundles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ScheduledActions) > 0 {
+		for iNdEx := len(m.ScheduledActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ChargeHistory) > 0 {
+		for iNdEx := len(m.ChargeHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChargeHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.SwingStoreExportDataHash) > 0 {
 		i -= len(m.SwingStoreExportDataHash)
 		copy(dAtA[i:], m.SwingStoreExportDataHash)
@@ -243,6 +381,50 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AccountChargeHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountChargeHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountChargeHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Charges) > 0 {
+		for iNdEx := len(m.Charges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Charges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SwingStoreExportDataEntry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -311,6 +493,43 @@ func (m *GenesisState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.ChargeHistory) > 0 {
+		for _, e := range m.ChargeHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ScheduledActions) > 0 {
+		for _, e := range m.ScheduledActions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.InstalledBundles) > 0 {
+		for _, e := range m.InstalledBundles {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AccountChargeHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Charges) > 0 {
+		for _, e := range m.Charges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -498,6 +717,226 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.SwingStoreExportDataHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChargeHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChargeHistory = append(m.ChargeHistory, AccountChargeHistory{})
+			if err := m.ChargeHistory[len(m.ChargeHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledActions = append(m.ScheduledActions, ScheduledAction{})
+			if err := m.ScheduledActions[len(m.ScheduledActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstalledBundles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InstalledBundles = append(m.InstalledBundles, InstalledBundle{})
+			if err := m.InstalledBundles[len(m.InstalledBundles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountChargeHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountChargeHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountChargeHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Charges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Charges = append(m.Charges, ChargeRecord{})
+			if err := m.Charges[len(m.Charges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
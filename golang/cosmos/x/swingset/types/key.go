@@ -6,4 +6,8 @@ const (
 
 	// StoreKey to be used when creating the KVStore
 	StoreKey = ModuleName
+
+	// TStoreKey to be used when creating the transient store, which counts
+	// the inbound admissions of the current block
+	TStoreKey = "transient_" + ModuleName
 )
@@ -21,12 +21,23 @@ var (
 	_ sdk.Msg = &MsgInstallBundle{}
 	_ sdk.Msg = &MsgWalletAction{}
 	_ sdk.Msg = &MsgWalletSpendAction{}
+	_ sdk.Msg = &MsgWalletActionBatch{}
+	_ sdk.Msg = &MsgScheduleAction{}
+	_ sdk.Msg = &MsgCancelScheduledAction{}
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgBeginBundleUpload{}
+	_ sdk.Msg = &MsgSendBundleChunk{}
+	_ sdk.Msg = &MsgFinalizeBundleUpload{}
 
 	_ vm.ControllerAdmissionMsg = &MsgDeliverInbound{}
 	_ vm.ControllerAdmissionMsg = &MsgInstallBundle{}
 	_ vm.ControllerAdmissionMsg = &MsgProvision{}
 	_ vm.ControllerAdmissionMsg = &MsgWalletAction{}
 	_ vm.ControllerAdmissionMsg = &MsgWalletSpendAction{}
+	_ vm.ControllerAdmissionMsg = &MsgWalletActionBatch{}
+	_ vm.ControllerAdmissionMsg = &MsgBeginBundleUpload{}
+	_ vm.ControllerAdmissionMsg = &MsgSendBundleChunk{}
+	_ vm.ControllerAdmissionMsg = &MsgFinalizeBundleUpload{}
 )
 
 // Contextual information about the message source of an action on an inbound queue.
@@ -52,6 +63,13 @@ const (
 	// bundleUncompressedSizeLimit is the (exclusive) limit on uncompressed bundle size.
 	// We must ensure there is an exclusive int64 limit in order to detect an underflow.
 	bundleUncompressedSizeLimit int64 = 10 * 1024 * 1024 // 10MB
+
+	// MaxWalletBatchActions is the maximum number of actions in a
+	// MsgWalletActionBatch.
+	MaxWalletBatchActions = 100
+	// MaxWalletBatchActionIDLength is the maximum length of the id of an action
+	// in a MsgWalletActionBatch.
+	MaxWalletBatchActionIDLength = 64
 )
 
 // Charge an account address for the beans associated with given messages and storage.
@@ -65,7 +83,7 @@ func chargeAdmission(ctx sdk.Context, keeper SwingSetKeeper, addr sdk.AccAddress
 	}
 	beans = beans.Add(beansPerUnit[BeansPerStorageByte].MulUint64(storageLen))
 
-	return keeper.ChargeBeans(ctx, addr, beans)
+	return keeper.ChargeBeans(ctx, addr, beans, ChargeTypeInboundAdmission)
 }
 
 // checkSmartWalletProvisioned verifies if a smart wallet message (MsgWalletAction
@@ -297,6 +315,111 @@ func (msg MsgWalletSpendAction) ValidateBasic() error {
 	return nil
 }
 
+func NewMsgWalletActionBatch(owner sdk.AccAddress, actions []WalletBatchAction) *MsgWalletActionBatch {
+	return &MsgWalletActionBatch{
+		Owner:   owner,
+		Actions: actions,
+	}
+}
+
+// hasSpendAction tells if any action of the batch spends the owner's assets.
+func (msg MsgWalletActionBatch) hasSpendAction() bool {
+	for _, action := range msg.Actions {
+		if action.Spend {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckAdmissibility implements the vm.ControllerAdmissionMsg interface.
+// The batch is charged as a single inbound Tx carrying each of its actions.
+func (msg MsgWalletActionBatch) CheckAdmissibility(ctx sdk.Context, data interface{}) error {
+	keeper, ok := data.(SwingSetKeeper)
+	if !ok {
+		return sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "data must be a SwingSetKeeper, not a %T", data)
+	}
+
+	err := checkSmartWalletProvisioned(ctx, keeper, msg.Owner)
+	if err != nil {
+		return err
+	}
+
+	actions := make([]string, len(msg.Actions))
+	for i, action := range msg.Actions {
+		actions[i] = action.Action
+	}
+	return chargeAdmission(ctx, keeper, msg.Owner, actions, 0)
+}
+
+// GetInboundMsgCount implements InboundMsgCarrier.
+func (msg MsgWalletActionBatch) GetInboundMsgCount() int32 {
+	return 1
+}
+
+// IsHighPriority implements the vm.ControllerAdmissionMsg interface.
+// Like a MsgWalletSpendAction, a batch with a spend action is high priority if
+// its owner is.
+func (msg MsgWalletActionBatch) IsHighPriority(ctx sdk.Context, data interface{}) (bool, error) {
+	if !msg.hasSpendAction() {
+		return false, nil
+	}
+	keeper, ok := data.(SwingSetKeeper)
+	if !ok {
+		return false, sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "data must be a SwingSetKeeper, not a %T", data)
+	}
+
+	return keeper.IsHighPriorityAddress(ctx, msg.Owner)
+}
+
+func (msg MsgWalletActionBatch) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgWalletActionBatch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleAminoCdc.MustMarshalJSON(&msg))
+}
+
+// Route should return the name of the module
+func (msg MsgWalletActionBatch) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgWalletActionBatch) Type() string { return "wallet_action_batch" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgWalletActionBatch) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return sdkioerrors.Wrap(sdkerrors.ErrInvalidAddress, "Owner address cannot be empty")
+	}
+	if len(msg.Actions) == 0 {
+		return sdkioerrors.Wrap(sdkerrors.ErrUnknownRequest, "Actions cannot be empty")
+	}
+	if len(msg.Actions) > MaxWalletBatchActions {
+		return sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "Batch cannot have more than %d actions", MaxWalletBatchActions)
+	}
+	ids := make(map[string]struct{}, len(msg.Actions))
+	for i, action := range msg.Actions {
+		if len(action.Id) == 0 {
+			return sdkioerrors.Wrapf(sdkerrors.ErrUnknownRequest, "Action %d id cannot be empty", i)
+		}
+		if len(action.Id) > MaxWalletBatchActionIDLength {
+			return sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "Action %d id cannot be longer than %d bytes", i, MaxWalletBatchActionIDLength)
+		}
+		if _, exists := ids[action.Id]; exists {
+			return sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "Action id %q is duplicated", action.Id)
+		}
+		ids[action.Id] = struct{}{}
+		if len(strings.TrimSpace(action.Action)) == 0 {
+			return sdkioerrors.Wrapf(sdkerrors.ErrUnknownRequest, "Action %q cannot be empty", action.Id)
+		}
+		if !json.Valid([]byte(action.Action)) {
+			return sdkioerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "Action %q must be valid JSON", action.Id)
+		}
+	}
+	return nil
+}
+
 func NewMsgProvision(nickname string, addr sdk.AccAddress, powerFlags []string, submitter sdk.AccAddress) *MsgProvision {
 	return &MsgProvision{
 		Nickname:   nickname,
@@ -481,3 +604,339 @@ func (msg *MsgInstallBundle) Uncompress() error {
 	msg.UncompressedSize = 0
 	return nil
 }
+
+// isSha512Hex returns whether s is a lowercase hex-encoded SHA-512 digest.
+func isSha512Hex(s string) bool {
+	if len(s) != 128 {
+		return false
+	}
+	for _, c := range s {
+		if !(c >= '0' && c <= '9' || c >= 'a' && c <= 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+func NewMsgBeginBundleUpload(submitter sdk.AccAddress, sha512 string, size, uncompressedSize int64) *MsgBeginBundleUpload {
+	return &MsgBeginBundleUpload{
+		Submitter:        submitter,
+		Sha512:           sha512,
+		Size_:            size,
+		UncompressedSize: uncompressedSize,
+	}
+}
+
+// CheckAdmissibility implements the vm.ControllerAdmissionMsg interface.
+// Beginning an upload is charged as a message, so that open uploads are not
+// free to create.
+func (msg MsgBeginBundleUpload) CheckAdmissibility(ctx sdk.Context, data interface{}) error {
+	keeper, ok := data.(SwingSetKeeper)
+	if !ok {
+		return sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "data must be a SwingSetKeeper, not a %T", data)
+	}
+	beans := keeper.GetBeansPerUnit(ctx)[BeansPerMessage]
+	return keeper.ChargeBeans(ctx, msg.Submitter, beans, ChargeTypeBundleUploadBegin)
+}
+
+// GetInboundMsgCount implements InboundMsgCarrier.
+func (msg MsgBeginBundleUpload) GetInboundMsgCount() int32 {
+	return 0
+}
+
+// IsHighPriority implements the vm.ControllerAdmissionMsg interface.
+func (msg MsgBeginBundleUpload) IsHighPriority(ctx sdk.Context, data interface{}) (bool, error) {
+	return false, nil
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgBeginBundleUpload) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Submitter}
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgBeginBundleUpload) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleAminoCdc.MustMarshalJSON(&msg))
+}
+
+// Route should return the name of the module
+func (msg MsgBeginBundleUpload) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgBeginBundleUpload) Type() string { return "begin_bundle_upload" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgBeginBundleUpload) ValidateBasic() error {
+	if msg.Submitter.Empty() {
+		return sdkioerrors.Wrap(sdkerrors.ErrInvalidAddress, "Submitter address cannot be empty")
+	}
+	if !isSha512Hex(msg.Sha512) {
+		return sdkioerrors.Wrap(sdkerrors.ErrInvalidRequest, "SHA-512 must be 128 lowercase hex digits")
+	}
+	if msg.Size_ <= 0 || msg.Size_ >= bundleUncompressedSizeLimit {
+		return sdkioerrors.Wrap(sdkerrors.ErrInvalidRequest, "Size out of range")
+	}
+	if msg.UncompressedSize < 0 || msg.UncompressedSize >= bundleUncompressedSizeLimit {
+		return sdkioerrors.Wrap(sdkerrors.ErrInvalidRequest, "Uncompressed size out of range")
+	}
+	return nil
+}
+
+func NewMsgSendBundleChunk(submitter sdk.AccAddress, uploadID, index uint64, data []byte) *MsgSendBundleChunk {
+	return &MsgSendBundleChunk{
+		Submitter: submitter,
+		UploadId:  uploadID,
+		Index:     index,
+		Data:      data,
+	}
+}
+
+// CheckAdmissibility implements the vm.ControllerAdmissionMsg interface.
+// Chunks are charged once by the byte when they are received, however long
+// they are then held until their upload is finalized or expires.
+func (msg MsgSendBundleChunk) CheckAdmissibility(ctx sdk.Context, data interface{}) error {
+	keeper, ok := data.(SwingSetKeeper)
+	if !ok {
+		return sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "data must be a SwingSetKeeper, not a %T", data)
+	}
+	beans := keeper.GetBeansPerUnit(ctx)[BeansPerMessageByte].MulUint64(uint64(len(msg.Data)))
+	return keeper.ChargeBeans(ctx, msg.Submitter, beans, ChargeTypeBundleChunk)
+}
+
+// GetInboundMsgCount implements InboundMsgCarrier.
+func (msg MsgSendBundleChunk) GetInboundMsgCount() int32 {
+	return 0
+}
+
+// IsHighPriority implements the vm.ControllerAdmissionMsg interface.
+func (msg MsgSendBundleChunk) IsHighPriority(ctx sdk.Context, data interface{}) (bool, error) {
+	return false, nil
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSendBundleChunk) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Submitter}
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSendBundleChunk) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleAminoCdc.MustMarshalJSON(&msg))
+}
+
+// Route should return the name of the module
+func (msg MsgSendBundleChunk) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSendBundleChunk) Type() string { return "send_bundle_chunk" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSendBundleChunk) ValidateBasic() error {
+	if msg.Submitter.Empty() {
+		return sdkioerrors.Wrap(sdkerrors.ErrInvalidAddress, "Submitter address cannot be empty")
+	}
+	if len(msg.Data) == 0 {
+		return sdkioerrors.Wrap(sdkerrors.ErrUnknownRequest, "Chunk data cannot be empty")
+	}
+	return nil
+}
+
+func NewMsgFinalizeBundleUpload(submitter sdk.AccAddress, uploadID uint64) *MsgFinalizeBundleUpload {
+	return &MsgFinalizeBundleUpload{
+		Submitter: submitter,
+		UploadId:  uploadID,
+	}
+}
+
+// CheckAdmissibility implements the vm.ControllerAdmissionMsg interface.
+// The chunks have already been charged by the byte, so only the inbound
+// transaction and the storage of the bundle remain to be charged.
+func (msg MsgFinalizeBundleUpload) CheckAdmissibility(ctx sdk.Context, data interface{}) error {
+	keeper, ok := data.(SwingSetKeeper)
+	if !ok {
+		return sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "data must be a SwingSetKeeper, not a %T", data)
+	}
+	upload, found := keeper.GetBundleUpload(ctx, msg.UploadId)
+	if !found {
+		return sdkioerrors.Wrapf(sdkerrors.ErrNotFound, "no bundle upload %d", msg.UploadId)
+	}
+	storageLen := upload.Size_
+	if upload.UncompressedSize > 0 {
+		storageLen = upload.UncompressedSize
+	}
+	return chargeAdmission(ctx, keeper, msg.Submitter, nil, uint64(storageLen))
+}
+
+// GetInboundMsgCount implements InboundMsgCarrier.
+func (msg MsgFinalizeBundleUpload) GetInboundMsgCount() int32 {
+	return 1
+}
+
+// IsHighPriority implements the vm.ControllerAdmissionMsg interface.
+func (msg MsgFinalizeBundleUpload) IsHighPriority(ctx sdk.Context, data interface{}) (bool, error) {
+	return false, nil
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgFinalizeBundleUpload) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Submitter}
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgFinalizeBundleUpload) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleAminoCdc.MustMarshalJSON(&msg))
+}
+
+// Route should return the name of the module
+func (msg MsgFinalizeBundleUpload) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgFinalizeBundleUpload) Type() string { return "finalize_bundle_upload" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgFinalizeBundleUpload) ValidateBasic() error {
+	if msg.Submitter.Empty() {
+		return sdkioerrors.Wrap(sdkerrors.ErrInvalidAddress, "Submitter address cannot be empty")
+	}
+	return nil
+}
+
+func NewMsgScheduleWalletAction(owner sdk.AccAddress, action string, spend bool, notBeforeHeight, notBeforeTime int64, escrow sdk.Coins) *MsgScheduleAction {
+	return &MsgScheduleAction{
+		Owner:           owner,
+		Action:          action,
+		Spend:           spend,
+		NotBeforeHeight: notBeforeHeight,
+		NotBeforeTime:   notBeforeTime,
+		Escrow:          escrow,
+	}
+}
+
+func (msg MsgScheduleAction) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgScheduleAction) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleAminoCdc.MustMarshalJSON(&msg))
+}
+
+// Route should return the name of the module
+func (msg MsgScheduleAction) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgScheduleAction) Type() string { return "schedule_action" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgScheduleAction) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return sdkioerrors.Wrap(sdkerrors.ErrInvalidAddress, "Owner address cannot be empty")
+	}
+	isCoreEval := len(msg.CoreEvals) > 0
+	hasAction := len(strings.TrimSpace(msg.Action)) > 0
+	switch {
+	case isCoreEval && (hasAction || msg.Spend):
+		return sdkioerrors.Wrap(sdkerrors.ErrInvalidRequest, "Cannot schedule both an action and core evals")
+	case !isCoreEval && !hasAction:
+		return sdkioerrors.Wrap(sdkerrors.ErrUnknownRequest, "Action cannot be empty")
+	case hasAction && !json.Valid([]byte(msg.Action)):
+		return sdkioerrors.Wrap(sdkerrors.ErrJSONUnmarshal, "Scheduled action must be valid JSON")
+	}
+	for _, eval := range msg.CoreEvals {
+		if len(eval.JsonPermits) == 0 || len(eval.JsCode) == 0 {
+			return sdkioerrors.Wrap(sdkerrors.ErrInvalidRequest, "Core evals must have permits and code")
+		}
+	}
+	if msg.NotBeforeHeight < 0 || msg.NotBeforeTime < 0 {
+		return sdkioerrors.Wrap(sdkerrors.ErrInvalidRequest, "Schedule cannot be negative")
+	}
+	if (msg.NotBeforeHeight == 0) == (msg.NotBeforeTime == 0) {
+		return sdkioerrors.Wrap(sdkerrors.ErrInvalidRequest, "Exactly one of a height or a time must be scheduled")
+	}
+	if err := msg.Escrow.Validate(); err != nil {
+		return sdkioerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	return nil
+}
+
+func NewMsgCancelScheduledAction(owner sdk.AccAddress, id uint64) *MsgCancelScheduledAction {
+	return &MsgCancelScheduledAction{
+		Owner: owner,
+		Id:    id,
+	}
+}
+
+func (msg MsgCancelScheduledAction) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgCancelScheduledAction) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleAminoCdc.MustMarshalJSON(&msg))
+}
+
+// Route should return the name of the module
+func (msg MsgCancelScheduledAction) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgCancelScheduledAction) Type() string { return "cancel_scheduled_action" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgCancelScheduledAction) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return sdkioerrors.Wrap(sdkerrors.ErrInvalidAddress, "Owner address cannot be empty")
+	}
+	return nil
+}
+
+func NewMsgUpdateParams(authority sdk.AccAddress, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    &params,
+	}
+}
+
+func NewMsgUpdateBeansPerUnit(authority sdk.AccAddress, beansPerUnit StringBeans) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority:    authority,
+		BeansPerUnit: &beansPerUnit,
+	}
+}
+
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Authority}
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleAminoCdc.MustMarshalJSON(&msg))
+}
+
+// Route should return the name of the module
+func (msg MsgUpdateParams) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgUpdateParams) Type() string { return "update_params" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if msg.Authority.Empty() {
+		return sdkioerrors.Wrap(sdkerrors.ErrInvalidAddress, "Authority address cannot be empty")
+	}
+	if (msg.Params == nil) == (msg.BeansPerUnit == nil) {
+		return sdkioerrors.Wrap(sdkerrors.ErrInvalidRequest, "Exactly one of params or a beans_per_unit entry must be updated")
+	}
+	if msg.Params != nil {
+		if err := msg.Params.ValidateBasic(); err != nil {
+			return sdkioerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
+	if msg.BeansPerUnit != nil {
+		if err := validateBeansPerUnit([]StringBeans{*msg.BeansPerUnit}); err != nil {
+			return sdkioerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		if msg.BeansPerUnit.Beans.IsNil() {
+			return sdkioerrors.Wrap(sdkerrors.ErrInvalidRequest, "beans must not be empty")
+		}
+	}
+	return nil
+}
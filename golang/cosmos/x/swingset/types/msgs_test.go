@@ -2,7 +2,9 @@ package types
 
 import (
 	"bytes"
+	"fmt"
 	"math"
+	"strings"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
@@ -92,6 +94,293 @@ func TestWalletSpendAction(t *testing.T) {
 	}
 }
 
+func TestWalletActionBatch(t *testing.T) {
+	tooMany := make([]WalletBatchAction, MaxWalletBatchActions+1)
+	for i := range tooMany {
+		tooMany[i] = WalletBatchAction{Id: fmt.Sprint(i), Action: "null"}
+	}
+	for _, tt := range []struct {
+		name      string
+		msg       *MsgWalletActionBatch
+		shouldErr bool
+	}{
+		{
+			name:      "empty",
+			msg:       &MsgWalletActionBatch{},
+			shouldErr: true,
+		},
+		{
+			name:      "no actions",
+			msg:       NewMsgWalletActionBatch(addr, nil),
+			shouldErr: true,
+		},
+		{
+			name: "normal",
+			msg: NewMsgWalletActionBatch(addr, []WalletBatchAction{
+				{Id: "offer-1", Action: "null"},
+				{Id: "offer-2", Action: "{}", Spend: true},
+			}),
+		},
+		{
+			name:      "too many actions",
+			msg:       NewMsgWalletActionBatch(addr, tooMany),
+			shouldErr: true,
+		},
+		{
+			name:      "empty id",
+			msg:       NewMsgWalletActionBatch(addr, []WalletBatchAction{{Action: "null"}}),
+			shouldErr: true,
+		},
+		{
+			name: "long id",
+			msg: NewMsgWalletActionBatch(addr, []WalletBatchAction{
+				{Id: strings.Repeat("x", MaxWalletBatchActionIDLength+1), Action: "null"},
+			}),
+			shouldErr: true,
+		},
+		{
+			name: "duplicate id",
+			msg: NewMsgWalletActionBatch(addr, []WalletBatchAction{
+				{Id: "offer-1", Action: "null"},
+				{Id: "offer-1", Action: "null"},
+			}),
+			shouldErr: true,
+		},
+		{
+			name:      "empty action",
+			msg:       NewMsgWalletActionBatch(addr, []WalletBatchAction{{Id: "offer-1"}}),
+			shouldErr: true,
+		},
+		{
+			name:      "bad json",
+			msg:       NewMsgWalletActionBatch(addr, []WalletBatchAction{{Id: "offer-1", Action: "foo"}}),
+			shouldErr: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if err != nil && !tt.shouldErr {
+				t.Fatalf("unexpected validation error %s", err)
+			}
+			if err == nil && tt.shouldErr {
+				t.Fatalf("wanted validation error")
+			}
+		})
+	}
+}
+
+func TestScheduleAction(t *testing.T) {
+	evals := []CoreEval{{JsonPermits: "true", JsCode: "() => {}"}}
+	escrow := sdk.NewCoins(sdk.NewInt64Coin("ubld", 100))
+	for _, tt := range []struct {
+		name      string
+		msg       *MsgScheduleAction
+		shouldErr bool
+	}{
+		{
+			name:      "empty",
+			msg:       &MsgScheduleAction{},
+			shouldErr: true,
+		},
+		{
+			name: "at height",
+			msg:  NewMsgScheduleWalletAction(addr, "{}", false, 100, 0, escrow),
+		},
+		{
+			name: "at time",
+			msg:  NewMsgScheduleWalletAction(addr, "{}", true, 0, 1700000000, escrow),
+		},
+		{
+			name:      "no schedule",
+			msg:       NewMsgScheduleWalletAction(addr, "{}", false, 0, 0, escrow),
+			shouldErr: true,
+		},
+		{
+			name:      "height and time",
+			msg:       NewMsgScheduleWalletAction(addr, "{}", false, 100, 1700000000, escrow),
+			shouldErr: true,
+		},
+		{
+			name:      "negative height",
+			msg:       NewMsgScheduleWalletAction(addr, "{}", false, -1, 0, escrow),
+			shouldErr: true,
+		},
+		{
+			name:      "empty action",
+			msg:       NewMsgScheduleWalletAction(addr, "", false, 100, 0, escrow),
+			shouldErr: true,
+		},
+		{
+			name:      "bad json",
+			msg:       NewMsgScheduleWalletAction(addr, "foo", false, 100, 0, escrow),
+			shouldErr: true,
+		},
+		{
+			name:      "bad escrow",
+			msg:       NewMsgScheduleWalletAction(addr, "{}", false, 100, 0, sdk.Coins{{Denom: "ubld", Amount: sdk.NewInt(-1)}}),
+			shouldErr: true,
+		},
+		{
+			name: "core evals",
+			msg:  &MsgScheduleAction{Owner: addr, CoreEvals: evals, NotBeforeHeight: 100},
+		},
+		{
+			name:      "core evals and action",
+			msg:       &MsgScheduleAction{Owner: addr, Action: "{}", CoreEvals: evals, NotBeforeHeight: 100},
+			shouldErr: true,
+		},
+		{
+			name:      "core eval without code",
+			msg:       &MsgScheduleAction{Owner: addr, CoreEvals: []CoreEval{{JsonPermits: "true"}}, NotBeforeHeight: 100},
+			shouldErr: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if err != nil && !tt.shouldErr {
+				t.Fatalf("unexpected validation error %s", err)
+			}
+			if err == nil && tt.shouldErr {
+				t.Fatalf("wanted validation error")
+			}
+		})
+	}
+}
+
+func TestBundleUpload_ValidateBasic(t *testing.T) {
+	hash := strings.Repeat("0123456789abcdef", 8)
+	for _, tt := range []struct {
+		name      string
+		msg       sdk.Msg
+		shouldErr bool
+	}{
+		{
+			name:      "empty begin",
+			msg:       &MsgBeginBundleUpload{},
+			shouldErr: true,
+		},
+		{
+			name: "begin uncompressed",
+			msg:  NewMsgBeginBundleUpload(addr, hash, 1000, 0),
+		},
+		{
+			name: "begin compressed",
+			msg:  NewMsgBeginBundleUpload(addr, hash, 1000, 5000),
+		},
+		{
+			name:      "begin bad hash",
+			msg:       NewMsgBeginBundleUpload(addr, strings.ToUpper(hash), 1000, 0),
+			shouldErr: true,
+		},
+		{
+			name:      "begin empty",
+			msg:       NewMsgBeginBundleUpload(addr, hash, 0, 0),
+			shouldErr: true,
+		},
+		{
+			name:      "begin too large",
+			msg:       NewMsgBeginBundleUpload(addr, hash, bundleUncompressedSizeLimit, 0),
+			shouldErr: true,
+		},
+		{
+			name:      "begin uncompressed too large",
+			msg:       NewMsgBeginBundleUpload(addr, hash, 1000, bundleUncompressedSizeLimit),
+			shouldErr: true,
+		},
+		{
+			name: "chunk",
+			msg:  NewMsgSendBundleChunk(addr, 1, 0, []byte("data")),
+		},
+		{
+			name:      "empty chunk",
+			msg:       NewMsgSendBundleChunk(addr, 1, 0, nil),
+			shouldErr: true,
+		},
+		{
+			name: "finalize",
+			msg:  NewMsgFinalizeBundleUpload(addr, 1),
+		},
+		{
+			name:      "finalize without submitter",
+			msg:       NewMsgFinalizeBundleUpload(nil, 1),
+			shouldErr: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if err != nil && !tt.shouldErr {
+				t.Fatalf("unexpected validation error %s", err)
+			}
+			if err == nil && tt.shouldErr {
+				t.Fatalf("wanted validation error")
+			}
+		})
+	}
+}
+
+func TestUpdateParams_ValidateBasic(t *testing.T) {
+	invalidParams := DefaultParams()
+	invalidParams.BeansPerUnit = []StringBeans{{Key: "", Beans: sdk.NewUint(1)}}
+	for _, tt := range []struct {
+		name      string
+		msg       *MsgUpdateParams
+		shouldErr bool
+	}{
+		{
+			name: "params",
+			msg:  NewMsgUpdateParams(addr, DefaultParams()),
+		},
+		{
+			name: "beans per unit",
+			msg:  NewMsgUpdateBeansPerUnit(addr, NewStringBeans(BeansPerInboundTx, sdk.NewUint(1000))),
+		},
+		{
+			name:      "no authority",
+			msg:       NewMsgUpdateParams(nil, DefaultParams()),
+			shouldErr: true,
+		},
+		{
+			name:      "nothing to update",
+			msg:       &MsgUpdateParams{Authority: addr},
+			shouldErr: true,
+		},
+		{
+			name: "params and beans per unit",
+			msg: &MsgUpdateParams{
+				Authority:    addr,
+				Params:       &invalidParams,
+				BeansPerUnit: &StringBeans{Key: BeansPerInboundTx, Beans: sdk.NewUint(1)},
+			},
+			shouldErr: true,
+		},
+		{
+			name:      "invalid params",
+			msg:       NewMsgUpdateParams(addr, invalidParams),
+			shouldErr: true,
+		},
+		{
+			name:      "empty beans key",
+			msg:       NewMsgUpdateBeansPerUnit(addr, NewStringBeans("", sdk.NewUint(1))),
+			shouldErr: true,
+		},
+		{
+			name:      "missing beans",
+			msg:       NewMsgUpdateBeansPerUnit(addr, StringBeans{Key: BeansPerInboundTx}),
+			shouldErr: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if err != nil && !tt.shouldErr {
+				t.Fatalf("unexpected validation error %s", err)
+			}
+			if err == nil && tt.shouldErr {
+				t.Fatalf("wanted validation error")
+			}
+		})
+	}
+}
+
 func TestInstallBundle_ValidateBasic(t *testing.T) {
 	for _, tt := range []struct {
 		name      string
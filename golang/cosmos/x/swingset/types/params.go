@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	yaml "gopkg.in/yaml.v2"
 
@@ -17,6 +18,7 @@ var (
 	ParamStoreKeyFeeUnitPrice       = []byte("fee_unit_price")
 	ParamStoreKeyPowerFlagFees      = []byte("power_flag_fees")
 	ParamStoreKeyQueueMax           = []byte("queue_max")
+	ParamStoreKeyInboundPricing     = []byte("inbound_pricing")
 )
 
 func NewStringBeans(key string, beans sdkmath.Uint) StringBeans {
@@ -53,6 +55,7 @@ func DefaultParams() Params {
 		FeeUnitPrice:       DefaultFeeUnitPrice,
 		PowerFlagFees:      DefaultPowerFlagFees,
 		QueueMax:           DefaultQueueMax,
+		InboundPricing:     DefaultInboundPricing,
 	}
 }
 
@@ -69,6 +72,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyBootstrapVatConfig, &p.BootstrapVatConfig, validateBootstrapVatConfig),
 		paramtypes.NewParamSetPair(ParamStoreKeyPowerFlagFees, &p.PowerFlagFees, validatePowerFlagFees),
 		paramtypes.NewParamSetPair(ParamStoreKeyQueueMax, &p.QueueMax, validateQueueMax),
+		paramtypes.NewParamSetPair(ParamStoreKeyInboundPricing, &p.InboundPricing, validateInboundPricing),
 	}
 }
 
@@ -89,6 +93,9 @@ func (p Params) ValidateBasic() error {
 	if err := validateQueueMax(p.QueueMax); err != nil {
 		return err
 	}
+	if err := validateInboundPricing(p.InboundPricing); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -158,10 +165,49 @@ func validatePowerFlagFees(i interface{}) error {
 }
 
 func validateQueueMax(i interface{}) error {
-	_, ok := i.([]QueueSize)
+	v, ok := i.([]QueueSize)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	admissionKeys := make(map[string]struct{})
+	for _, qs := range v {
+		if qs.Key != QueueInboundSender && !strings.HasPrefix(qs.Key, QueueInboundMsgTypePrefix) {
+			continue
+		}
+		if qs.Key == QueueInboundMsgTypePrefix {
+			return fmt.Errorf("queue size %s must name a message type", qs.Key)
+		}
+		if qs.Size_ < 0 {
+			return fmt.Errorf("queue size %s must not be negative", qs.Key)
+		}
+		if _, exists := admissionKeys[qs.Key]; exists {
+			return fmt.Errorf("duplicate queue size %s", qs.Key)
+		}
+		admissionKeys[qs.Key] = struct{}{}
+	}
+	return nil
+}
+
+func validateInboundPricing(i interface{}) error {
+	v, ok := i.(InboundPricing)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
+	if !v.Enabled {
+		return nil
+	}
+	if v.TargetQueuePercent == 0 || v.TargetQueuePercent > 100 {
+		return fmt.Errorf("inbound pricing target queue percent must be between 1 and 100: %d", v.TargetQueuePercent)
+	}
+	if v.MaxChangePercent > 100 {
+		return fmt.Errorf("inbound pricing max change percent must not exceed 100: %d", v.MaxChangePercent)
+	}
+	if v.MinBeans.IsNil() || v.MinBeans.IsZero() {
+		return fmt.Errorf("inbound pricing min beans must be positive")
+	}
+	if v.MaxBeans.IsNil() || v.MaxBeans.LT(v.MinBeans) {
+		return fmt.Errorf("inbound pricing max beans must not be less than min beans")
+	}
 	return nil
 }
 
@@ -186,9 +232,31 @@ func UpdateParams(params Params) (Params, error) {
 	params.BeansPerUnit = newBpu
 	params.PowerFlagFees = newPff
 	params.QueueMax = newQm
+	if params.InboundPricing.MinBeans.IsNil() {
+		// Inbound pricing has never been set.
+		params.InboundPricing = DefaultInboundPricing
+	}
 	return params, nil
 }
 
+// SetBeansPerUnit returns a copy of bpu in which entry replaces the entry with
+// the same key, or is appended if there is none.
+func SetBeansPerUnit(bpu []StringBeans, entry StringBeans) []StringBeans {
+	newBpu := make([]StringBeans, 0, len(bpu)+1)
+	found := false
+	for _, sb := range bpu {
+		if sb.Key == entry.Key {
+			sb = entry
+			found = true
+		}
+		newBpu = append(newBpu, sb)
+	}
+	if !found {
+		newBpu = append(newBpu, entry)
+	}
+	return newBpu
+}
+
 // appendMissingDefaultBeansPerUnit appends the default beans per unit entries
 // not in the list of bean costs already, returning the possibly-updated list,
 // or an error.
@@ -105,6 +105,7 @@ func TestUpdateParams(t *testing.T) {
 		FeeUnitPrice:       sdk.NewCoins(sdk.NewInt64Coin("denom", 789)),
 		PowerFlagFees:      DefaultPowerFlagFees,
 		QueueMax:           DefaultQueueMax,
+		InboundPricing:     DefaultInboundPricing,
 	}
 	got, err := UpdateParams(in)
 	if err != nil {
@@ -113,4 +114,88 @@ func TestUpdateParams(t *testing.T) {
 	if !reflect.DeepEqual(got, want) {
 		t.Errorf("got %v, want %v", got, want)
 	}
+
+	// Existing inbound pricing is kept.
+	in.InboundPricing = DefaultInboundPricing
+	in.InboundPricing.Enabled = true
+	got, err = UpdateParams(in)
+	if err != nil {
+		t.Fatalf("UpdateParam error %v", err)
+	}
+	if got.InboundPricing != in.InboundPricing {
+		t.Errorf("got inbound pricing %v, want %v", got.InboundPricing, in.InboundPricing)
+	}
+}
+
+func TestSetBeansPerUnit(t *testing.T) {
+	bpu := []StringBeans{
+		NewStringBeans("a", sdk.NewUint(1)),
+		NewStringBeans("b", sdk.NewUint(2)),
+	}
+	got := SetBeansPerUnit(bpu, NewStringBeans("b", sdk.NewUint(20)))
+	want := []StringBeans{
+		NewStringBeans("a", sdk.NewUint(1)),
+		NewStringBeans("b", sdk.NewUint(20)),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("replace: got %v, want %v", got, want)
+	}
+	if !bpu[1].Beans.Equal(sdk.NewUint(2)) {
+		t.Errorf("original entry was modified")
+	}
+
+	got = SetBeansPerUnit(bpu, NewStringBeans("c", sdk.NewUint(3)))
+	want = append(append([]StringBeans{}, bpu...), NewStringBeans("c", sdk.NewUint(3)))
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("append: got %v, want %v", got, want)
+	}
+}
+
+func TestValidateQueueMax(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		queueMax []QueueSize
+		wantErr  bool
+	}{
+		{
+			name:     "default",
+			queueMax: DefaultQueueMax,
+		},
+		{
+			name: "admission-budgets",
+			queueMax: []QueueSize{
+				NewQueueSize(QueueInbound, 1000),
+				NewQueueSize(QueueInboundMsgTypePrefix+"/agoric.swingset.MsgDeliverInbound", 10),
+				NewQueueSize(QueueInboundMsgTypePrefix+"/agoric.swingset.MsgWalletSpendAction", 0),
+				NewQueueSize(QueueInboundSender, 5),
+			},
+		},
+		{
+			name:     "missing-msg-type",
+			queueMax: []QueueSize{NewQueueSize(QueueInboundMsgTypePrefix, 10)},
+			wantErr:  true,
+		},
+		{
+			name:     "negative-budget",
+			queueMax: []QueueSize{NewQueueSize(QueueInboundSender, -1)},
+			wantErr:  true,
+		},
+		{
+			name: "duplicate-budget",
+			queueMax: []QueueSize{
+				NewQueueSize(QueueInboundSender, 5),
+				NewQueueSize(QueueInboundSender, 6),
+			},
+			wantErr: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateQueueMax(tt.queueMax)
+			if tt.wantErr && err == nil {
+				t.Errorf("want error, got none")
+			} else if !tt.wantErr && err != nil {
+				t.Errorf("want no error, got %v", err)
+			}
+		})
+	}
 }
@@ -25,6 +25,34 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Compression is the encoding of the data of a chunk.
+type SwingStoreArtifactChunk_Compression int32
+
+const (
+	// The data is not compressed.
+	SwingStoreArtifactChunk_COMPRESSION_NONE SwingStoreArtifactChunk_Compression = 0
+	// The data is gzip-compressed.
+	SwingStoreArtifactChunk_COMPRESSION_GZIP SwingStoreArtifactChunk_Compression = 1
+)
+
+var SwingStoreArtifactChunk_Compression_name = map[int32]string{
+	0: "COMPRESSION_NONE",
+	1: "COMPRESSION_GZIP",
+}
+
+var SwingStoreArtifactChunk_Compression_value = map[string]int32{
+	"COMPRESSION_NONE": 0,
+	"COMPRESSION_GZIP": 1,
+}
+
+func (x SwingStoreArtifactChunk_Compression) String() string {
+	return proto.EnumName(SwingStoreArtifactChunk_Compression_name, int32(x))
+}
+
+func (SwingStoreArtifactChunk_Compression) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{12, 0}
+}
+
 // CoreEvalProposal is a gov Content type for evaluating code in the SwingSet
 // core.
 // See `bridgeCoreEval` in agoric-sdk packages/vats/src/core/chain-behaviors.js.
@@ -161,6 +189,12 @@ type Params struct {
 	// nodes must all serialize and deserialize the existing order without
 	// permuting it.
 	QueueMax []QueueSize `protobuf:"bytes,5,rep,name=queue_max,json=queueMax,proto3" json:"queue_max"`
+	// Optional congestion pricing of inbound messages.  When enabled, the
+	// price in beans of each inbound message rises or falls every block
+	// according to the length of the inbound queue relative to the "inbound"
+	// entry of queue_max, and Txs whose fee does not cover that price for
+	// their inbound messages are rejected.
+	InboundPricing InboundPricing `protobuf:"bytes,6,opt,name=inbound_pricing,json=inboundPricing,proto3" json:"inbound_pricing"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -230,6 +264,13 @@ func (m *Params) GetQueueMax() []QueueSize {
 	return nil
 }
 
+func (m *Params) GetInboundPricing() InboundPricing {
+	if m != nil {
+		return m.InboundPricing
+	}
+	return InboundPricing{}
+}
+
 // The current state of the module.
 type State struct {
 	// The allowed number of items to add to queues, as determined by SwingSet.
@@ -433,6 +474,77 @@ func (m *QueueSize) GetSize_() int32 {
 	return 0
 }
 
+// Parameters of the congestion pricing of inbound messages.
+type InboundPricing struct {
+	// Whether the price is adjusted and enforced.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The inbound queue length, in percent of its maximum, at which the price
+	// holds steady.  The price rises when the queue is longer and falls when it
+	// is shorter.
+	TargetQueuePercent uint32 `protobuf:"varint,2,opt,name=target_queue_percent,json=targetQueuePercent,proto3" json:"target_queue_percent,omitempty"`
+	// The largest change of the price in one block, in percent of the price.
+	MaxChangePercent uint32 `protobuf:"varint,3,opt,name=max_change_percent,json=maxChangePercent,proto3" json:"max_change_percent,omitempty"`
+	// The lowest price in beans per inbound message, which is also the initial
+	// price.  Must be positive when enabled.
+	MinBeans github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,4,opt,name=min_beans,json=minBeans,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"min_beans"`
+	// The highest price in beans per inbound message.
+	MaxBeans github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,5,opt,name=max_beans,json=maxBeans,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"max_beans"`
+}
+
+func (m *InboundPricing) Reset()         { *m = InboundPricing{} }
+func (m *InboundPricing) String() string { return proto.CompactTextString(m) }
+func (*InboundPricing) ProtoMessage()    {}
+func (*InboundPricing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{7}
+}
+func (m *InboundPricing) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InboundPricing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InboundPricing.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InboundPricing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InboundPricing.Merge(m, src)
+}
+func (m *InboundPricing) XXX_Size() int {
+	return m.Size()
+}
+func (m *InboundPricing) XXX_DiscardUnknown() {
+	xxx_messageInfo_InboundPricing.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InboundPricing proto.InternalMessageInfo
+
+func (m *InboundPricing) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *InboundPricing) GetTargetQueuePercent() uint32 {
+	if m != nil {
+		return m.TargetQueuePercent
+	}
+	return 0
+}
+
+func (m *InboundPricing) GetMaxChangePercent() uint32 {
+	if m != nil {
+		return m.MaxChangePercent
+	}
+	return 0
+}
+
 // Egress is the format for a swingset egress.
 type Egress struct {
 	Nickname string                                        `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname" yaml:"nickname"`
@@ -445,7 +557,7 @@ func (m *Egress) Reset()         { *m = Egress{} }
 func (m *Egress) String() string { return proto.CompactTextString(m) }
 func (*Egress) ProtoMessage()    {}
 func (*Egress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9c341e0de15f8b, []int{7}
+	return fileDescriptor_ff9c341e0de15f8b, []int{8}
 }
 func (m *Egress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -495,6 +607,206 @@ func (m *Egress) GetPowerFlags() []string {
 	return nil
 }
 
+// ChargeRecord is a fee charged to an account by the swingset module.
+type ChargeRecord struct {
+	BlockHeight int64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"blockHeight" yaml:"blockHeight"`
+	// The hash of the transaction that incurred the charge, or "unknown".
+	TxHash string `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"txHash" yaml:"txHash"`
+	// What the charge is for, e.g. "inboundAdmission", "smartWalletProvision",
+	// or "provisionPowerFlags".
+	ChargeType string `protobuf:"bytes,3,opt,name=charge_type,json=chargeType,proto3" json:"chargeType" yaml:"chargeType"`
+	// The beans charged, or zero for a charge denominated in coins.
+	Beans github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,4,opt,name=beans,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"beans" yaml:"beans"`
+	// The coins debited from the account by the charge, which for a charge in
+	// beans includes any beans previously owing.
+	Debited github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=debited,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"debited" yaml:"debited"`
+	// The beans owed by the account after the charge.
+	BeansOwing github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,6,opt,name=beans_owing,json=beansOwing,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"beansOwing" yaml:"beansOwing"`
+}
+
+func (m *ChargeRecord) Reset()         { *m = ChargeRecord{} }
+func (m *ChargeRecord) String() string { return proto.CompactTextString(m) }
+func (*ChargeRecord) ProtoMessage()    {}
+func (*ChargeRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{9}
+}
+func (m *ChargeRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChargeRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChargeRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChargeRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChargeRecord.Merge(m, src)
+}
+func (m *ChargeRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChargeRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChargeRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChargeRecord proto.InternalMessageInfo
+
+func (m *ChargeRecord) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *ChargeRecord) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *ChargeRecord) GetChargeType() string {
+	if m != nil {
+		return m.ChargeType
+	}
+	return ""
+}
+
+func (m *ChargeRecord) GetDebited() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Debited
+	}
+	return nil
+}
+
+// ScheduledAction is an action stored by MsgScheduleAction, to be delivered
+// to SwingSet at the start of the first block at or after its height or time.
+type ScheduledAction struct {
+	Id    uint64                                        `protobuf:"varint,1,opt,name=id,proto3" json:"id" yaml:"id"`
+	Owner github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner" yaml:"owner"`
+	// The wallet action to perform, as JSON-stringified marshalled data, if
+	// this is not a core eval.
+	Action string `protobuf:"bytes,3,opt,name=action,proto3" json:"action" yaml:"action"`
+	// Whether the wallet action spends the owner's assets, as for
+	// MsgWalletSpendAction.
+	Spend bool `protobuf:"varint,4,opt,name=spend,proto3" json:"spend" yaml:"spend"`
+	// The evaluations of a core eval, which only the governance module account
+	// may schedule.
+	CoreEvals []CoreEval `protobuf:"bytes,5,rep,name=core_evals,json=coreEvals,proto3" json:"coreEvals" yaml:"coreEvals"`
+	// The block height at or after which to deliver the action, or zero.
+	NotBeforeHeight int64 `protobuf:"varint,6,opt,name=not_before_height,json=notBeforeHeight,proto3" json:"notBeforeHeight" yaml:"notBeforeHeight"`
+	// The block time in seconds since the epoch at or after which to deliver
+	// the action, or zero.
+	NotBeforeTime int64 `protobuf:"varint,7,opt,name=not_before_time,json=notBeforeTime,proto3" json:"notBeforeTime" yaml:"notBeforeTime"`
+	// The fee held in escrow by the swingset module, paid to the fee collector
+	// on delivery or refunded to the owner on cancellation.
+	Escrow github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=escrow,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"escrow" yaml:"escrow"`
+	// The block height at which the action was scheduled.
+	ScheduledHeight int64 `protobuf:"varint,9,opt,name=scheduled_height,json=scheduledHeight,proto3" json:"scheduledHeight" yaml:"scheduledHeight"`
+}
+
+func (m *ScheduledAction) Reset()         { *m = ScheduledAction{} }
+func (m *ScheduledAction) String() string { return proto.CompactTextString(m) }
+func (*ScheduledAction) ProtoMessage()    {}
+func (*ScheduledAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{10}
+}
+func (m *ScheduledAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledAction.Merge(m, src)
+}
+func (m *ScheduledAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledAction proto.InternalMessageInfo
+
+func (m *ScheduledAction) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ScheduledAction) GetOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *ScheduledAction) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *ScheduledAction) GetSpend() bool {
+	if m != nil {
+		return m.Spend
+	}
+	return false
+}
+
+func (m *ScheduledAction) GetCoreEvals() []CoreEval {
+	if m != nil {
+		return m.CoreEvals
+	}
+	return nil
+}
+
+func (m *ScheduledAction) GetNotBeforeHeight() int64 {
+	if m != nil {
+		return m.NotBeforeHeight
+	}
+	return 0
+}
+
+func (m *ScheduledAction) GetNotBeforeTime() int64 {
+	if m != nil {
+		return m.NotBeforeTime
+	}
+	return 0
+}
+
+func (m *ScheduledAction) GetEscrow() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Escrow
+	}
+	return nil
+}
+
+func (m *ScheduledAction) GetScheduledHeight() int64 {
+	if m != nil {
+		return m.ScheduledHeight
+	}
+	return 0
+}
+
 // SwingStoreArtifact encodes an artifact of a swing-store export.
 // Artifacts may be stored or transmitted in any order. Most handlers do
 // maintain the artifact order from their original source as an effect of how
@@ -508,7 +820,7 @@ func (m *SwingStoreArtifact) Reset()         { *m = SwingStoreArtifact{} }
 func (m *SwingStoreArtifact) String() string { return proto.CompactTextString(m) }
 func (*SwingStoreArtifact) ProtoMessage()    {}
 func (*SwingStoreArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9c341e0de15f8b, []int{8}
+	return fileDescriptor_ff9c341e0de15f8b, []int{11}
 }
 func (m *SwingStoreArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -551,110 +863,459 @@ func (m *SwingStoreArtifact) GetData() []byte {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*CoreEvalProposal)(nil), "agoric.swingset.CoreEvalProposal")
-	proto.RegisterType((*CoreEval)(nil), "agoric.swingset.CoreEval")
-	proto.RegisterType((*Params)(nil), "agoric.swingset.Params")
-	proto.RegisterType((*State)(nil), "agoric.swingset.State")
-	proto.RegisterType((*StringBeans)(nil), "agoric.swingset.StringBeans")
-	proto.RegisterType((*PowerFlagFee)(nil), "agoric.swingset.PowerFlagFee")
-	proto.RegisterType((*QueueSize)(nil), "agoric.swingset.QueueSize")
-	proto.RegisterType((*Egress)(nil), "agoric.swingset.Egress")
-	proto.RegisterType((*SwingStoreArtifact)(nil), "agoric.swingset.SwingStoreArtifact")
+// SwingStoreArtifactChunk is a bounded-size piece of a SwingStoreArtifact, as
+// written in the payloads of state-sync snapshot extension format 2.  The
+// chunks of an artifact are consecutive and numbered from 0.
+type SwingStoreArtifactChunk struct {
+	// The name of the artifact.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name" yaml:"name"`
+	// The index of the chunk within the artifact.
+	Index uint64 `protobuf:"varint,2,opt,name=index,proto3" json:"index" yaml:"index"`
+	// Whether this is the last chunk of the artifact.
+	Last        bool                                `protobuf:"varint,3,opt,name=last,proto3" json:"last" yaml:"last"`
+	Compression SwingStoreArtifactChunk_Compression `protobuf:"varint,4,opt,name=compression,proto3,enum=agoric.swingset.SwingStoreArtifactChunk_Compression" json:"compression" yaml:"compression"`
+	// The size in bytes of the chunk once uncompressed.
+	Size_ uint64 `protobuf:"varint,5,opt,name=size,proto3" json:"size" yaml:"size"`
+	// The SHA-256 of the chunk once uncompressed.
+	Sha256 []byte `protobuf:"bytes,6,opt,name=sha256,proto3" json:"sha256" yaml:"sha256"`
+	Data   []byte `protobuf:"bytes,7,opt,name=data,proto3" json:"data" yaml:"data"`
 }
 
-func init() { proto.RegisterFile("agoric/swingset/swingset.proto", fileDescriptor_ff9c341e0de15f8b) }
+func (m *SwingStoreArtifactChunk) Reset()         { *m = SwingStoreArtifactChunk{} }
+func (m *SwingStoreArtifactChunk) String() string { return proto.CompactTextString(m) }
+func (*SwingStoreArtifactChunk) ProtoMessage()    {}
+func (*SwingStoreArtifactChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{12}
+}
+func (m *SwingStoreArtifactChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwingStoreArtifactChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwingStoreArtifactChunk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwingStoreArtifactChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwingStoreArtifactChunk.Merge(m, src)
+}
+func (m *SwingStoreArtifactChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwingStoreArtifactChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwingStoreArtifactChunk.DiscardUnknown(m)
+}
 
-var fileDescriptor_ff9c341e0de15f8b = []byte{
-	// 842 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0x8e, 0x49, 0x52, 0x9a, 0x97, 0x6c, 0xbb, 0x0c, 0x95, 0x36, 0x54, 0x6c, 0xa6, 0xf2, 0x85,
-	0x4a, 0xab, 0x8d, 0xb7, 0x20, 0x84, 0x94, 0x15, 0x87, 0xb8, 0xea, 0x6a, 0x25, 0x04, 0x0a, 0x8e,
-	0xca, 0x01, 0x81, 0xac, 0x89, 0x33, 0x31, 0xd3, 0x3a, 0x1e, 0xef, 0xcc, 0xf4, 0xd7, 0xfe, 0x03,
-	0x70, 0x41, 0x42, 0x9c, 0x38, 0xf6, 0xcc, 0x5f, 0xb2, 0xc7, 0x3d, 0x22, 0x0e, 0x06, 0xb5, 0x17,
-	0xd4, 0x63, 0x8e, 0x48, 0x48, 0x68, 0x66, 0x1c, 0xc7, 0xa2, 0x48, 0xf4, 0xc2, 0x29, 0xf3, 0x7e,
-	0x7d, 0xef, 0x7d, 0xdf, 0x1b, 0x4f, 0xa0, 0x47, 0x62, 0x2e, 0x58, 0xe4, 0xc9, 0x33, 0x96, 0xc6,
-	0x92, 0xaa, 0xf2, 0xd0, 0xcf, 0x04, 0x57, 0x1c, 0x6d, 0xda, 0x78, 0x7f, 0xe9, 0xde, 0xde, 0x8a,
-	0x79, 0xcc, 0x4d, 0xcc, 0xd3, 0x27, 0x9b, 0xb6, 0xdd, 0x8b, 0xb8, 0x9c, 0x73, 0xe9, 0x4d, 0x88,
-	0xa4, 0xde, 0xe9, 0xde, 0x84, 0x2a, 0xb2, 0xe7, 0x45, 0x9c, 0xa5, 0x36, 0xee, 0x7e, 0xeb, 0xc0,
-	0xfd, 0x7d, 0x2e, 0xe8, 0xc1, 0x29, 0x49, 0x46, 0x82, 0x67, 0x5c, 0x92, 0x04, 0x6d, 0x41, 0x53,
-	0x31, 0x95, 0xd0, 0xae, 0xb3, 0xe3, 0xec, 0xb6, 0x02, 0x6b, 0xa0, 0x1d, 0x68, 0x4f, 0xa9, 0x8c,
-	0x04, 0xcb, 0x14, 0xe3, 0x69, 0xf7, 0x0d, 0x13, 0xab, 0xba, 0xd0, 0x87, 0xd0, 0xa4, 0xa7, 0x24,
-	0x91, 0xdd, 0xfa, 0x4e, 0x7d, 0xb7, 0xfd, 0xfe, 0x3b, 0xfd, 0x7f, 0xcc, 0xd8, 0x5f, 0x76, 0xf2,
-	0x1b, 0xaf, 0x72, 0x5c, 0x0b, 0x6c, 0xf6, 0xa0, 0xf1, 0xdd, 0x25, 0xae, 0xb9, 0x12, 0xd6, 0x97,
-	0x61, 0x34, 0x80, 0xce, 0x91, 0xe4, 0x69, 0x98, 0x51, 0x31, 0x67, 0x4a, 0xda, 0x39, 0xfc, 0x07,
-	0x8b, 0x1c, 0xbf, 0x7d, 0x41, 0xe6, 0xc9, 0xc0, 0xad, 0x46, 0xdd, 0xa0, 0xad, 0xcd, 0x91, 0xb5,
-	0xd0, 0x23, 0x78, 0xf3, 0x48, 0x86, 0x11, 0x9f, 0x52, 0x3b, 0xa2, 0x8f, 0x16, 0x39, 0xde, 0x58,
-	0x96, 0x99, 0x80, 0x1b, 0xac, 0x1d, 0xc9, 0x7d, 0x7d, 0xf8, 0xbe, 0x0e, 0x6b, 0x23, 0x22, 0xc8,
-	0x5c, 0xa2, 0xe7, 0xb0, 0x31, 0xa1, 0x24, 0x95, 0x1a, 0x36, 0x3c, 0x49, 0x99, 0xea, 0x3a, 0x86,
-	0xc5, 0xbb, 0xb7, 0x58, 0x8c, 0x95, 0x60, 0x69, 0xec, 0xeb, 0xe4, 0x82, 0x48, 0xc7, 0x54, 0x8e,
-	0xa8, 0x38, 0x4c, 0x99, 0x42, 0x2f, 0x60, 0x63, 0x46, 0xa9, 0xc1, 0x08, 0x33, 0xc1, 0x22, 0x3d,
-	0x88, 0xd5, 0xc3, 0x2e, 0xa3, 0xaf, 0x97, 0xd1, 0x2f, 0x96, 0xd1, 0xdf, 0xe7, 0x2c, 0xf5, 0x9f,
-	0x68, 0x98, 0x9f, 0x7f, 0xc3, 0xbb, 0x31, 0x53, 0xdf, 0x9c, 0x4c, 0xfa, 0x11, 0x9f, 0x7b, 0xc5,
-	0xe6, 0xec, 0xcf, 0x63, 0x39, 0x3d, 0xf6, 0xd4, 0x45, 0x46, 0xa5, 0x29, 0x90, 0x41, 0x67, 0x46,
-	0xa9, 0xee, 0x36, 0xd2, 0x0d, 0xd0, 0x13, 0xd8, 0x9a, 0x70, 0xae, 0xa4, 0x12, 0x24, 0x0b, 0x4f,
-	0x89, 0x0a, 0x23, 0x9e, 0xce, 0x58, 0xdc, 0xad, 0x9b, 0x25, 0xa1, 0x32, 0xf6, 0x05, 0x51, 0xfb,
-	0x26, 0x82, 0x3e, 0x81, 0xcd, 0x8c, 0x9f, 0x51, 0x11, 0xce, 0x12, 0x12, 0x87, 0x33, 0x4a, 0x65,
-	0xb7, 0x61, 0xa6, 0x7c, 0x78, 0x8b, 0xef, 0x48, 0xe7, 0x3d, 0x4b, 0x48, 0xfc, 0x8c, 0xd2, 0x82,
-	0xf0, 0xbd, 0xac, 0xe2, 0x93, 0xe8, 0x63, 0x68, 0xbd, 0x38, 0xa1, 0x27, 0x34, 0x9c, 0x93, 0xf3,
-	0x6e, 0xd3, 0xc0, 0x6c, 0xdf, 0x82, 0xf9, 0x5c, 0x67, 0x8c, 0xd9, 0xcb, 0x25, 0xc6, 0xba, 0x29,
-	0xf9, 0x94, 0x9c, 0x0f, 0xd6, 0x7f, 0xba, 0xc4, 0xb5, 0x3f, 0x2e, 0xb1, 0xe3, 0x7e, 0x06, 0xcd,
-	0xb1, 0x22, 0x8a, 0xa2, 0x03, 0xb8, 0x67, 0x11, 0x49, 0x92, 0xf0, 0x33, 0x3a, 0x2d, 0x96, 0xf1,
-	0xdf, 0xa8, 0x1d, 0x53, 0x36, 0xb4, 0x55, 0x6e, 0x02, 0xed, 0xca, 0xb6, 0xd0, 0x7d, 0xa8, 0x1f,
-	0xd3, 0x8b, 0xe2, 0x5a, 0xeb, 0x23, 0x3a, 0x80, 0xa6, 0xd9, 0x5d, 0x71, 0x57, 0x3c, 0x8d, 0xf1,
-	0x6b, 0x8e, 0xdf, 0xbb, 0xc3, 0x1e, 0x0e, 0x59, 0xaa, 0x02, 0x5b, 0x3d, 0x68, 0x98, 0xe9, 0x7f,
-	0x74, 0xa0, 0x53, 0x15, 0x0b, 0x3d, 0x04, 0x58, 0x89, 0x5c, 0xb4, 0x6d, 0x95, 0xd2, 0xa1, 0xaf,
-	0xa1, 0x3e, 0xa3, 0xff, 0xcb, 0xed, 0xd0, 0xb8, 0xc5, 0x50, 0x1f, 0x41, 0xab, 0xd4, 0xe8, 0x5f,
-	0x04, 0x40, 0xd0, 0x90, 0xec, 0xa5, 0xfd, 0x56, 0x9a, 0x81, 0x39, 0x17, 0x85, 0x7f, 0x39, 0xb0,
-	0x76, 0x10, 0x0b, 0x2a, 0x25, 0x7a, 0x0a, 0xeb, 0x29, 0x8b, 0x8e, 0x53, 0x32, 0x2f, 0xde, 0x04,
-	0x1f, 0xdf, 0xe4, 0xb8, 0xf4, 0x2d, 0x72, 0xbc, 0x69, 0x3f, 0xb0, 0xa5, 0xc7, 0x0d, 0xca, 0x20,
-	0xfa, 0x0a, 0x1a, 0x19, 0xa5, 0xc2, 0x74, 0xe8, 0xf8, 0xcf, 0x6f, 0x72, 0x6c, 0xec, 0x45, 0x8e,
-	0xdb, 0xb6, 0x48, 0x5b, 0xee, 0x9f, 0x39, 0x7e, 0x7c, 0x07, 0x7a, 0xc3, 0x28, 0x1a, 0x4e, 0xa7,
-	0x7a, 0xa8, 0xc0, 0xa0, 0xa0, 0x00, 0xda, 0x2b, 0x89, 0xed, 0xcb, 0xd3, 0xf2, 0xf7, 0xae, 0x72,
-	0x0c, 0xe5, 0x26, 0xe4, 0x4d, 0x8e, 0xa1, 0x54, 0x5d, 0x2e, 0x72, 0xfc, 0x56, 0xd1, 0xb8, 0xf4,
-	0xb9, 0x41, 0x25, 0xc1, 0xf0, 0xaf, 0xb9, 0x0a, 0xd0, 0x58, 0xdf, 0xb2, 0xb1, 0xe2, 0x82, 0x0e,
-	0x85, 0x62, 0x33, 0x12, 0x29, 0xf4, 0x08, 0x1a, 0x15, 0x19, 0x1e, 0x68, 0x36, 0x85, 0x04, 0x05,
-	0x1b, 0x4b, 0xdf, 0x38, 0x75, 0xf2, 0x94, 0x28, 0x52, 0x50, 0x37, 0xc9, 0xda, 0x5e, 0x25, 0x6b,
-	0xcb, 0x0d, 0x8c, 0xd3, 0x76, 0xf5, 0x0f, 0x5f, 0x5d, 0xf5, 0x9c, 0xd7, 0x57, 0x3d, 0xe7, 0xf7,
-	0xab, 0x9e, 0xf3, 0xc3, 0x75, 0xaf, 0xf6, 0xfa, 0xba, 0x57, 0xfb, 0xe5, 0xba, 0x57, 0xfb, 0xf2,
-	0x69, 0x45, 0x9e, 0xa1, 0xfd, 0x73, 0xb0, 0x1f, 0x83, 0x91, 0x27, 0xe6, 0x09, 0x49, 0xe3, 0xa5,
-	0x6e, 0xe7, 0xab, 0xff, 0x0d, 0xa3, 0xdb, 0x64, 0xcd, 0x3c, 0xf7, 0x1f, 0xfc, 0x1d, 0x00, 0x00,
-	0xff, 0xff, 0xee, 0x34, 0x5f, 0xf6, 0x57, 0x06, 0x00, 0x00,
+var xxx_messageInfo_SwingStoreArtifactChunk proto.InternalMessageInfo
+
+func (m *SwingStoreArtifactChunk) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *SwingStoreArtifactChunk) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
 	}
+	return 0
+}
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *SwingStoreArtifactChunk) GetLast() bool {
+	if m != nil {
+		return m.Last
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return false
+}
+
+func (m *SwingStoreArtifactChunk) GetCompression() SwingStoreArtifactChunk_Compression {
+	if m != nil {
+		return m.Compression
 	}
-	if len(this.BeansPerUnit) != len(that1.BeansPerUnit) {
-		return false
+	return SwingStoreArtifactChunk_COMPRESSION_NONE
+}
+
+func (m *SwingStoreArtifactChunk) GetSize_() uint64 {
+	if m != nil {
+		return m.Size_
 	}
-	for i := range this.BeansPerUnit {
-		if !this.BeansPerUnit[i].Equal(&that1.BeansPerUnit[i]) {
-			return false
-		}
+	return 0
+}
+
+func (m *SwingStoreArtifactChunk) GetSha256() []byte {
+	if m != nil {
+		return m.Sha256
 	}
-	if len(this.FeeUnitPrice) != len(that1.FeeUnitPrice) {
-		return false
+	return nil
+}
+
+func (m *SwingStoreArtifactChunk) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	for i := range this.FeeUnitPrice {
-		if !this.FeeUnitPrice[i].Equal(&that1.FeeUnitPrice[i]) {
-			return false
+	return nil
+}
+
+// InstalledBundle records a bundle submitted by MsgInstallBundle once the
+// controller has validated and installed it.
+type InstalledBundle struct {
+	// The bundle ID, "b1-" followed by the endoZipBase64Sha512 of the bundle.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" yaml:"id"`
+	// The account that submitted the bundle when it was first installed.
+	Submitter github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=submitter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"submitter" yaml:"submitter"`
+	// The size of the uncompressed bundle in bytes.
+	Size_ int64 `protobuf:"varint,3,opt,name=size,proto3" json:"size" yaml:"size"`
+	// The block height at which the bundle was first installed.
+	BlockHeight int64 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"blockHeight" yaml:"blockHeight"`
+}
+
+func (m *InstalledBundle) Reset()         { *m = InstalledBundle{} }
+func (m *InstalledBundle) String() string { return proto.CompactTextString(m) }
+func (*InstalledBundle) ProtoMessage()    {}
+func (*InstalledBundle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{13}
+}
+func (m *InstalledBundle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InstalledBundle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InstalledBundle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InstalledBundle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InstalledBundle.Merge(m, src)
+}
+func (m *InstalledBundle) XXX_Size() int {
+	return m.Size()
+}
+func (m *InstalledBundle) XXX_DiscardUnknown() {
+	xxx_messageInfo_InstalledBundle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InstalledBundle proto.InternalMessageInfo
+
+func (m *InstalledBundle) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *InstalledBundle) GetSubmitter() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Submitter
+	}
+	return nil
+}
+
+func (m *InstalledBundle) GetSize_() int64 {
+	if m != nil {
+		return m.Size_
+	}
+	return 0
+}
+
+func (m *InstalledBundle) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+// BundleUpload is the progress of a chunked bundle upload.
+type BundleUpload struct {
+	Id        uint64                                        `protobuf:"varint,1,opt,name=id,proto3" json:"id" yaml:"id"`
+	Submitter github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=submitter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"submitter" yaml:"submitter"`
+	// Hex-encoded SHA-512 of the data to be uploaded.
+	Sha512 string `protobuf:"bytes,3,opt,name=sha512,proto3" json:"sha512" yaml:"sha512"`
+	// Size in bytes of the data to be uploaded.
+	Size_ int64 `protobuf:"varint,4,opt,name=size,proto3" json:"size" yaml:"size"`
+	// Size in bytes of uncompression of the data, or 0 if it is uncompressed.
+	UncompressedSize int64 `protobuf:"varint,5,opt,name=uncompressed_size,json=uncompressedSize,proto3" json:"uncompressedSize" yaml:"uncompressedSize"`
+	// Number of chunks received so far.
+	ChunksReceived uint64 `protobuf:"varint,6,opt,name=chunks_received,json=chunksReceived,proto3" json:"chunksReceived" yaml:"chunksReceived"`
+	// Number of bytes received so far.
+	BytesReceived int64 `protobuf:"varint,7,opt,name=bytes_received,json=bytesReceived,proto3" json:"bytesReceived" yaml:"bytesReceived"`
+	// The block height after which the upload and its chunks are discarded.
+	ExpiryHeight int64 `protobuf:"varint,8,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiryHeight" yaml:"expiryHeight"`
+}
+
+func (m *BundleUpload) Reset()         { *m = BundleUpload{} }
+func (m *BundleUpload) String() string { return proto.CompactTextString(m) }
+func (*BundleUpload) ProtoMessage()    {}
+func (*BundleUpload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{14}
+}
+func (m *BundleUpload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BundleUpload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BundleUpload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BundleUpload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BundleUpload.Merge(m, src)
+}
+func (m *BundleUpload) XXX_Size() int {
+	return m.Size()
+}
+func (m *BundleUpload) XXX_DiscardUnknown() {
+	xxx_messageInfo_BundleUpload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BundleUpload proto.InternalMessageInfo
+
+func (m *BundleUpload) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *BundleUpload) GetSubmitter() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Submitter
+	}
+	return nil
+}
+
+func (m *BundleUpload) GetSha512() string {
+	if m != nil {
+		return m.Sha512
+	}
+	return ""
+}
+
+func (m *BundleUpload) GetSize_() int64 {
+	if m != nil {
+		return m.Size_
+	}
+	return 0
+}
+
+func (m *BundleUpload) GetUncompressedSize() int64 {
+	if m != nil {
+		return m.UncompressedSize
+	}
+	return 0
+}
+
+func (m *BundleUpload) GetChunksReceived() uint64 {
+	if m != nil {
+		return m.ChunksReceived
+	}
+	return 0
+}
+
+func (m *BundleUpload) GetBytesReceived() int64 {
+	if m != nil {
+		return m.BytesReceived
+	}
+	return 0
+}
+
+func (m *BundleUpload) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("agoric.swingset.SwingStoreArtifactChunk_Compression", SwingStoreArtifactChunk_Compression_name, SwingStoreArtifactChunk_Compression_value)
+	proto.RegisterType((*CoreEvalProposal)(nil), "agoric.swingset.CoreEvalProposal")
+	proto.RegisterType((*CoreEval)(nil), "agoric.swingset.CoreEval")
+	proto.RegisterType((*Params)(nil), "agoric.swingset.Params")
+	proto.RegisterType((*State)(nil), "agoric.swingset.State")
+	proto.RegisterType((*StringBeans)(nil), "agoric.swingset.StringBeans")
+	proto.RegisterType((*PowerFlagFee)(nil), "agoric.swingset.PowerFlagFee")
+	proto.RegisterType((*QueueSize)(nil), "agoric.swingset.QueueSize")
+	proto.RegisterType((*InboundPricing)(nil), "agoric.swingset.InboundPricing")
+	proto.RegisterType((*Egress)(nil), "agoric.swingset.Egress")
+	proto.RegisterType((*ChargeRecord)(nil), "agoric.swingset.ChargeRecord")
+	proto.RegisterType((*ScheduledAction)(nil), "agoric.swingset.ScheduledAction")
+	proto.RegisterType((*SwingStoreArtifact)(nil), "agoric.swingset.SwingStoreArtifact")
+	proto.RegisterType((*SwingStoreArtifactChunk)(nil), "agoric.swingset.SwingStoreArtifactChunk")
+	proto.RegisterType((*InstalledBundle)(nil), "agoric.swingset.InstalledBundle")
+	proto.RegisterType((*BundleUpload)(nil), "agoric.swingset.BundleUpload")
+}
+
+func init() { proto.RegisterFile("agoric/swingset/swingset.proto", fileDescriptor_ff9c341e0de15f8b) }
+
+var fileDescriptor_ff9c341e0de15f8b = []byte{
+	// 1815 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x6f, 0x23, 0x49,
+	0x15, 0x8f, 0x63, 0x3b, 0xb1, 0xcb, 0xce, 0xc7, 0xd4, 0x06, 0xc6, 0x3b, 0xb0, 0xe9, 0x51, 0xad,
+	0x56, 0x1b, 0x34, 0x8c, 0xbd, 0xc9, 0x6e, 0x40, 0x64, 0xc5, 0x21, 0x6d, 0xb2, 0x64, 0xd8, 0x9d,
+	0x8c, 0xb7, 0x3c, 0x83, 0x60, 0xb5, 0xd0, 0x94, 0xbb, 0x2b, 0x76, 0x4d, 0xda, 0xdd, 0xde, 0xae,
+	0x72, 0xe2, 0xac, 0x04, 0x07, 0x2e, 0x70, 0x44, 0x9c, 0x38, 0xce, 0x85, 0x0b, 0xe2, 0xc8, 0x1f,
+	0xb1, 0xc7, 0x3d, 0x22, 0x24, 0x1a, 0x98, 0xb9, 0x20, 0x4b, 0x5c, 0xcc, 0x0d, 0x09, 0x09, 0xd5,
+	0x47, 0x7f, 0xc4, 0x59, 0x34, 0x49, 0x24, 0x38, 0xb9, 0xdf, 0xef, 0xbd, 0xfa, 0xd5, 0xab, 0xf7,
+	0x55, 0x25, 0x83, 0x4d, 0xd2, 0x0f, 0x23, 0xe6, 0xb6, 0xf8, 0x19, 0x0b, 0xfa, 0x9c, 0x8a, 0xf4,
+	0xa3, 0x39, 0x8a, 0x42, 0x11, 0xc2, 0x35, 0xad, 0x6f, 0x26, 0xf0, 0x9d, 0x8d, 0x7e, 0xd8, 0x0f,
+	0x95, 0xae, 0x25, 0xbf, 0xb4, 0xd9, 0x9d, 0x4d, 0x37, 0xe4, 0xc3, 0x90, 0xb7, 0x7a, 0x84, 0xd3,
+	0xd6, 0xe9, 0x76, 0x8f, 0x0a, 0xb2, 0xdd, 0x72, 0x43, 0x16, 0x68, 0x3d, 0xfa, 0x45, 0x01, 0xac,
+	0xb7, 0xc3, 0x88, 0x1e, 0x9c, 0x12, 0xbf, 0x13, 0x85, 0xa3, 0x90, 0x13, 0x1f, 0x6e, 0x80, 0xb2,
+	0x60, 0xc2, 0xa7, 0x8d, 0xc2, 0xdd, 0xc2, 0x56, 0x15, 0x6b, 0x01, 0xde, 0x05, 0x35, 0x8f, 0x72,
+	0x37, 0x62, 0x23, 0xc1, 0xc2, 0xa0, 0xb1, 0xa8, 0x74, 0x79, 0x08, 0xee, 0x82, 0x32, 0x3d, 0x25,
+	0x3e, 0x6f, 0x14, 0xef, 0x16, 0xb7, 0x6a, 0x3b, 0xaf, 0x36, 0xe7, 0x7c, 0x6c, 0x26, 0x3b, 0xd9,
+	0xa5, 0xcf, 0x62, 0x6b, 0x01, 0x6b, 0xeb, 0xbd, 0xd2, 0x2f, 0x9f, 0x59, 0x0b, 0x88, 0x83, 0x4a,
+	0xa2, 0x86, 0x7b, 0xa0, 0xfe, 0x94, 0x87, 0x81, 0x33, 0xa2, 0xd1, 0x90, 0x09, 0xae, 0xfd, 0xb0,
+	0x6f, 0xcf, 0x62, 0xeb, 0x95, 0x73, 0x32, 0xf4, 0xf7, 0x50, 0x5e, 0x8b, 0x70, 0x4d, 0x8a, 0x1d,
+	0x2d, 0xc1, 0x7b, 0x60, 0xf9, 0x29, 0x77, 0xdc, 0xd0, 0xa3, 0xda, 0x45, 0x1b, 0xce, 0x62, 0x6b,
+	0x35, 0x59, 0xa6, 0x14, 0x08, 0x2f, 0x3d, 0xe5, 0x6d, 0xf9, 0xf1, 0xe7, 0x22, 0x58, 0xea, 0x90,
+	0x88, 0x0c, 0x39, 0x3c, 0x04, 0xab, 0x3d, 0x4a, 0x02, 0x2e, 0x69, 0x9d, 0x71, 0xc0, 0x44, 0xa3,
+	0xa0, 0x4e, 0xf1, 0xd5, 0x4b, 0xa7, 0xe8, 0x8a, 0x88, 0x05, 0x7d, 0x5b, 0x1a, 0x9b, 0x83, 0xd4,
+	0xd5, 0xca, 0x0e, 0x8d, 0x9e, 0x04, 0x4c, 0xc0, 0x4f, 0xc0, 0xea, 0x31, 0xa5, 0x8a, 0xc3, 0x19,
+	0x45, 0xcc, 0x95, 0x8e, 0xe8, 0x78, 0xe8, 0x64, 0x34, 0x65, 0x32, 0x9a, 0x26, 0x19, 0xcd, 0x76,
+	0xc8, 0x02, 0xfb, 0x2d, 0x49, 0xf3, 0xbb, 0xbf, 0x58, 0x5b, 0x7d, 0x26, 0x06, 0xe3, 0x5e, 0xd3,
+	0x0d, 0x87, 0x2d, 0x93, 0x39, 0xfd, 0x73, 0x9f, 0x7b, 0x27, 0x2d, 0x71, 0x3e, 0xa2, 0x5c, 0x2d,
+	0xe0, 0xb8, 0x7e, 0x4c, 0xa9, 0xdc, 0xad, 0x23, 0x37, 0x80, 0x6f, 0x81, 0x8d, 0x5e, 0x18, 0x0a,
+	0x2e, 0x22, 0x32, 0x72, 0x4e, 0x89, 0x70, 0xdc, 0x30, 0x38, 0x66, 0xfd, 0x46, 0x51, 0x25, 0x09,
+	0xa6, 0xba, 0xef, 0x13, 0xd1, 0x56, 0x1a, 0xf8, 0x3e, 0x58, 0x1b, 0x85, 0x67, 0x34, 0x72, 0x8e,
+	0x7d, 0xd2, 0x77, 0x8e, 0x29, 0xe5, 0x8d, 0x92, 0xf2, 0xf2, 0xb5, 0x4b, 0xe7, 0xed, 0x48, 0xbb,
+	0xf7, 0x7c, 0xd2, 0x7f, 0x8f, 0x52, 0x73, 0xe0, 0x95, 0x51, 0x0e, 0xe3, 0xf0, 0xdb, 0xa0, 0xfa,
+	0xc9, 0x98, 0x8e, 0xa9, 0x33, 0x24, 0x93, 0x46, 0x59, 0xd1, 0xdc, 0xb9, 0x44, 0xf3, 0xa1, 0xb4,
+	0xe8, 0xb2, 0x4f, 0x13, 0x8e, 0x8a, 0x5a, 0xf2, 0x90, 0x4c, 0xe0, 0x11, 0x58, 0x63, 0x41, 0x2f,
+	0x1c, 0x07, 0x9e, 0x8a, 0x17, 0x0b, 0xfa, 0x8d, 0xa5, 0xbb, 0x85, 0xad, 0xda, 0x8e, 0x75, 0x89,
+	0xe4, 0x81, 0xb6, 0xeb, 0x68, 0x33, 0xc3, 0xb4, 0xca, 0x2e, 0xa0, 0x7b, 0x95, 0xdf, 0x3c, 0xb3,
+	0x16, 0xfe, 0xfe, 0xcc, 0x2a, 0xa0, 0x23, 0x50, 0xee, 0x0a, 0x22, 0x28, 0x3c, 0x00, 0x2b, 0xda,
+	0x43, 0xe2, 0xfb, 0xe1, 0x19, 0xf5, 0x1a, 0x85, 0x2b, 0x7a, 0x59, 0x57, 0xcb, 0xf6, 0xf5, 0x2a,
+	0xe4, 0x83, 0x5a, 0x2e, 0xfb, 0x70, 0x1d, 0x14, 0x4f, 0xe8, 0xb9, 0x69, 0x13, 0xf9, 0x09, 0x0f,
+	0x40, 0x59, 0xd5, 0x82, 0xa9, 0xbd, 0x96, 0xe4, 0xf8, 0x53, 0x6c, 0xbd, 0x79, 0x85, 0xbc, 0x3e,
+	0x61, 0x81, 0xc0, 0x7a, 0xf5, 0x5e, 0x49, 0x79, 0xff, 0xeb, 0x02, 0xa8, 0xe7, 0x83, 0x0f, 0x5f,
+	0x03, 0x20, 0x4b, 0x9a, 0xd9, 0xb6, 0x9a, 0xa6, 0x02, 0xfe, 0x08, 0x14, 0x8f, 0xe9, 0xff, 0xa4,
+	0xda, 0x24, 0xaf, 0x71, 0xea, 0x9b, 0xa0, 0x9a, 0xc6, 0xe8, 0x0b, 0x02, 0x00, 0x41, 0x89, 0xb3,
+	0x4f, 0x75, 0xef, 0x95, 0xb1, 0xfa, 0x36, 0x0b, 0x7f, 0xbf, 0x08, 0x56, 0x2f, 0xa6, 0x0f, 0x36,
+	0xc0, 0x32, 0x0d, 0x48, 0xcf, 0x57, 0xf9, 0x28, 0x6c, 0x55, 0x70, 0x22, 0xca, 0x82, 0x16, 0x24,
+	0xea, 0x53, 0xe1, 0xe8, 0xb4, 0x8d, 0x68, 0xe4, 0xd2, 0x40, 0x28, 0xda, 0x15, 0x0c, 0xb5, 0x4e,
+	0xf9, 0xd1, 0xd1, 0x1a, 0xf8, 0x75, 0x00, 0x87, 0x64, 0xe2, 0xb8, 0x03, 0x12, 0xf4, 0x33, 0xfb,
+	0xa2, 0xb2, 0x5f, 0x1f, 0x92, 0x49, 0x5b, 0x29, 0x12, 0xeb, 0x0f, 0x40, 0x75, 0xc8, 0x02, 0x47,
+	0xe7, 0xaa, 0x74, 0xb3, 0x5c, 0x55, 0x86, 0x2c, 0xd0, 0x75, 0x20, 0xd9, 0xc8, 0xc4, 0xb0, 0x95,
+	0x6f, 0xca, 0x46, 0x26, 0x76, 0x2e, 0xf9, 0xff, 0x2e, 0x80, 0xa5, 0x83, 0x7e, 0x44, 0x39, 0x87,
+	0xef, 0x82, 0x4a, 0xc0, 0xdc, 0x93, 0x80, 0x0c, 0xcd, 0x48, 0xb6, 0xad, 0x69, 0x6c, 0xa5, 0xd8,
+	0x2c, 0xb6, 0xd6, 0xf4, 0x7c, 0x4b, 0x10, 0x84, 0x53, 0x25, 0xfc, 0x18, 0x94, 0x46, 0x94, 0x46,
+	0x2a, 0x72, 0x75, 0xfb, 0x70, 0x1a, 0x5b, 0x4a, 0x9e, 0xc5, 0x56, 0x4d, 0x2f, 0x92, 0x12, 0xfa,
+	0x57, 0x6c, 0xdd, 0xbf, 0x82, 0xa7, 0xfb, 0xae, 0xbb, 0xef, 0x79, 0xd2, 0x29, 0xac, 0x58, 0x20,
+	0x06, 0xb5, 0xac, 0x22, 0xf5, 0xe0, 0xaf, 0xda, 0xdb, 0xcf, 0x63, 0x0b, 0xa4, 0x85, 0xcb, 0xa7,
+	0xb1, 0x05, 0xd2, 0x22, 0xe5, 0xb3, 0xd8, 0xba, 0x65, 0x36, 0x4e, 0x31, 0x84, 0x73, 0x06, 0xea,
+	0xfc, 0x0b, 0xe8, 0x0f, 0x25, 0x50, 0x6f, 0x0f, 0x64, 0x9e, 0x31, 0x75, 0xc3, 0xc8, 0x83, 0x87,
+	0xa0, 0xde, 0xf3, 0x43, 0xf7, 0xc4, 0x19, 0x50, 0xd6, 0x1f, 0x08, 0x15, 0x89, 0xa2, 0xfd, 0xc6,
+	0x34, 0xb6, 0x6a, 0x0a, 0x3f, 0x54, 0xf0, 0x2c, 0xb6, 0xa0, 0xa6, 0xcf, 0x81, 0x08, 0xe7, 0x4d,
+	0xe0, 0x3b, 0x60, 0x59, 0x4c, 0x9c, 0x01, 0xe1, 0x03, 0xd3, 0xa6, 0x5f, 0x99, 0xc6, 0xd6, 0x92,
+	0x98, 0x1c, 0x12, 0x3e, 0x98, 0xc5, 0xd6, 0x8a, 0x5e, 0xaf, 0x65, 0x84, 0x8d, 0x02, 0x7e, 0x07,
+	0xd4, 0x5c, 0xe5, 0x8f, 0x23, 0x63, 0xa1, 0x47, 0xab, 0xfd, 0xba, 0x3c, 0x9c, 0x86, 0x1f, 0x9f,
+	0x8f, 0x68, 0x76, 0xb8, 0x0c, 0x43, 0x38, 0x67, 0x00, 0x7f, 0x0c, 0xca, 0xf9, 0xa2, 0x3b, 0xbc,
+	0x66, 0x99, 0x4c, 0x63, 0x4b, 0xaf, 0x9f, 0xc5, 0x56, 0xdd, 0x9c, 0x53, 0x8a, 0xc8, 0x4c, 0x0e,
+	0xf8, 0xf3, 0x02, 0x58, 0xf6, 0x68, 0x8f, 0x09, 0xea, 0x35, 0xca, 0x2f, 0x1b, 0x04, 0x0f, 0xe5,
+	0xee, 0xd3, 0xd8, 0x4a, 0x56, 0x64, 0x37, 0xa5, 0x01, 0xd0, 0xb5, 0xa6, 0x44, 0x42, 0x03, 0x39,
+	0xa8, 0xe9, 0xbb, 0x34, 0x3c, 0x4b, 0x86, 0x79, 0xd5, 0xc6, 0xd7, 0x3f, 0x2a, 0x50, 0x2c, 0x8f,
+	0x24, 0x49, 0x16, 0xd9, 0x0c, 0x43, 0x38, 0x67, 0x60, 0xca, 0xe6, 0x1f, 0x65, 0xb0, 0xd6, 0x75,
+	0x07, 0xd4, 0x1b, 0xfb, 0xd4, 0xdb, 0x77, 0xd5, 0xbb, 0xe4, 0x75, 0xb0, 0xc8, 0xf4, 0x84, 0x29,
+	0xd9, 0xaf, 0x4c, 0x63, 0x6b, 0x91, 0xc9, 0x93, 0x56, 0x35, 0x1d, 0xf3, 0x10, 0x5e, 0x64, 0x1e,
+	0xfc, 0x09, 0x28, 0x87, 0x67, 0x41, 0xda, 0x28, 0xdf, 0x93, 0x91, 0x56, 0x40, 0x16, 0x69, 0x25,
+	0xde, 0xa0, 0x55, 0x34, 0x0f, 0x7c, 0x1b, 0x2c, 0x11, 0xe5, 0x50, 0xa3, 0x98, 0x55, 0x9d, 0x46,
+	0xb2, 0xaa, 0xd3, 0x32, 0xc2, 0x46, 0x01, 0x5b, 0xa0, 0xcc, 0x47, 0x34, 0xf0, 0x54, 0xbd, 0x54,
+	0xec, 0x57, 0xa5, 0x5b, 0x0a, 0xc8, 0xdc, 0x52, 0x22, 0xc2, 0x1a, 0x86, 0x0e, 0x00, 0x6e, 0x18,
+	0x51, 0x47, 0xbf, 0xc4, 0xca, 0x2f, 0x7b, 0x89, 0xbd, 0x61, 0x4a, 0xa0, 0xea, 0x1a, 0x44, 0x56,
+	0xd6, 0xba, 0xa9, 0xe1, 0x04, 0x42, 0x38, 0x53, 0xc3, 0x1f, 0x82, 0x5b, 0x41, 0x28, 0x9c, 0x1e,
+	0x3d, 0x96, 0xdb, 0x98, 0x66, 0x5c, 0x52, 0xcd, 0x78, 0x7f, 0x1a, 0x5b, 0x6b, 0x41, 0x28, 0x6c,
+	0xa5, 0x4b, 0x1b, 0xf2, 0xcb, 0x66, 0x3a, 0x5d, 0x54, 0x20, 0x3c, 0x6f, 0x0a, 0x3f, 0x04, 0x6b,
+	0x39, 0x6a, 0xc1, 0x86, 0xb4, 0xb1, 0xac, 0x88, 0xbf, 0x36, 0x8d, 0xad, 0x95, 0xd4, 0xfa, 0x31,
+	0x53, 0x43, 0x6f, 0x63, 0x8e, 0x56, 0xc2, 0x08, 0x5f, 0x34, 0x83, 0x3f, 0x03, 0x4b, 0xf2, 0x85,
+	0x1a, 0x9e, 0x35, 0x2a, 0x2f, 0xeb, 0x86, 0xf7, 0x4d, 0x28, 0xcc, 0x82, 0x2c, 0x27, 0x5a, 0xbe,
+	0x5e, 0x2f, 0x18, 0x12, 0xf8, 0x03, 0xb0, 0xce, 0x93, 0x72, 0x4c, 0x82, 0x55, 0xcd, 0x82, 0x95,
+	0xea, 0xe6, 0x83, 0x35, 0xa7, 0x40, 0x78, 0xde, 0xd4, 0xd4, 0xbb, 0x00, 0xb0, 0x2b, 0x93, 0xda,
+	0x15, 0x61, 0x44, 0xf7, 0x23, 0xc1, 0x8e, 0x89, 0x2b, 0xe0, 0x3d, 0x50, 0xca, 0xdd, 0x16, 0xb7,
+	0xe5, 0xd0, 0x37, 0x37, 0x85, 0x19, 0xfa, 0xfa, 0x96, 0x50, 0xa0, 0x34, 0xf6, 0x88, 0x20, 0xa6,
+	0xf0, 0x95, 0xb1, 0x94, 0x33, 0x63, 0x29, 0x21, 0xac, 0x40, 0xb3, 0xeb, 0x3f, 0x8b, 0xe0, 0xf6,
+	0xe5, 0x6d, 0xdb, 0x83, 0x71, 0x70, 0x72, 0xbd, 0xbd, 0x5b, 0xa0, 0xcc, 0x02, 0x8f, 0x4e, 0xd4,
+	0xe6, 0x25, 0x5d, 0xde, 0x0a, 0xc8, 0xca, 0x5b, 0x89, 0x08, 0x6b, 0x58, 0xb2, 0xfb, 0x84, 0xeb,
+	0x8b, 0xbd, 0xa2, 0xd9, 0xa5, 0x9c, 0xb1, 0x4b, 0x09, 0x61, 0x05, 0xc2, 0x9f, 0x82, 0x9a, 0x1b,
+	0x0e, 0x47, 0xb2, 0x09, 0x65, 0xdb, 0xc9, 0x16, 0x5a, 0xdd, 0x79, 0xe7, 0xf2, 0x83, 0xfe, 0x8b,
+	0x4f, 0xd2, 0x6c, 0x67, 0x6b, 0xf5, 0x3d, 0x93, 0x23, 0xcb, 0xee, 0x99, 0x1c, 0x88, 0x70, 0xde,
+	0x44, 0xfa, 0xaa, 0xde, 0x42, 0x65, 0x75, 0x36, 0xe5, 0xab, 0x94, 0x33, 0x5f, 0xa5, 0x84, 0xf4,
+	0x23, 0x49, 0x4e, 0x07, 0x3e, 0x20, 0x3b, 0xbb, 0xdf, 0x50, 0xbd, 0x54, 0xd7, 0xd3, 0x41, 0x23,
+	0x59, 0x25, 0x6a, 0x19, 0x61, 0xa3, 0x48, 0x53, 0xb7, 0x7c, 0x85, 0xd4, 0xa1, 0x6f, 0x81, 0x5a,
+	0xee, 0x44, 0x70, 0x03, 0xac, 0xb7, 0x1f, 0x3d, 0xec, 0xe0, 0x83, 0x6e, 0xf7, 0xc1, 0xa3, 0x23,
+	0xe7, 0xe8, 0xd1, 0xd1, 0xc1, 0xfa, 0xc2, 0x3c, 0xfa, 0xdd, 0x8f, 0x1e, 0x74, 0xd6, 0x0b, 0x26,
+	0xeb, 0xbf, 0x5d, 0x04, 0x6b, 0x0f, 0x02, 0x2e, 0x88, 0xef, 0x53, 0xcf, 0x1e, 0x07, 0x9e, 0x4f,
+	0x73, 0xb3, 0xb5, 0xfa, 0xdf, 0x67, 0xeb, 0x08, 0x54, 0xf9, 0xb8, 0x37, 0x64, 0x42, 0xa4, 0xf3,
+	0x15, 0xcb, 0x99, 0x93, 0x82, 0xd9, 0xcc, 0x49, 0xa1, 0x1b, 0xcc, 0xd9, 0x8c, 0x2f, 0x0d, 0x7d,
+	0x51, 0xb5, 0xda, 0x4b, 0x42, 0x3f, 0xff, 0xb2, 0x28, 0xdd, 0xf4, 0x65, 0x61, 0xe2, 0xf4, 0xb7,
+	0x12, 0xa8, 0xeb, 0xf0, 0x3c, 0x19, 0xf9, 0x21, 0xf1, 0xae, 0x76, 0x01, 0xfd, 0xff, 0x83, 0xa4,
+	0x4b, 0x6e, 0x77, 0x7b, 0x27, 0x7f, 0x21, 0x69, 0xe4, 0x42, 0xc9, 0xed, 0x6e, 0xef, 0xe8, 0x92,
+	0xdb, 0xdd, 0xde, 0x49, 0x23, 0x5b, 0xba, 0x4a, 0x64, 0x3f, 0x06, 0xb7, 0xc6, 0x41, 0xd2, 0x12,
+	0xd4, 0x73, 0xd2, 0x76, 0x28, 0xda, 0xad, 0x69, 0x6c, 0xad, 0xe7, 0x95, 0x5d, 0xcd, 0x72, 0x5b,
+	0xb3, 0xcc, 0x6b, 0x10, 0xbe, 0x64, 0x0c, 0x1f, 0x83, 0x35, 0x57, 0x36, 0x2a, 0x77, 0x22, 0xea,
+	0x52, 0x76, 0x4a, 0x3d, 0xd5, 0x3b, 0x25, 0xfb, 0xde, 0x34, 0xb6, 0x56, 0xb5, 0x0a, 0x1b, 0xcd,
+	0x2c, 0xb6, 0xbe, 0x94, 0xbc, 0xcc, 0xf2, 0x38, 0xc2, 0x73, 0x86, 0xb0, 0x03, 0x56, 0x7b, 0xe7,
+	0x82, 0xe6, 0x48, 0x73, 0x77, 0x90, 0xd2, 0xe4, 0x38, 0xcd, 0x1d, 0x74, 0x01, 0x46, 0xf8, 0xa2,
+	0x19, 0xfc, 0x00, 0xac, 0xd0, 0xc9, 0x88, 0x45, 0xe7, 0x49, 0x81, 0x55, 0x14, 0xe1, 0x9b, 0xd3,
+	0xd8, 0xaa, 0x6b, 0x45, 0x5a, 0x61, 0xe6, 0xff, 0x8d, 0x3c, 0x8a, 0xf0, 0x05, 0x23, 0x5d, 0x63,
+	0xf6, 0x93, 0xcf, 0x9e, 0x6f, 0x16, 0x3e, 0x7f, 0xbe, 0x59, 0xf8, 0xeb, 0xf3, 0xcd, 0xc2, 0xaf,
+	0x5e, 0x6c, 0x2e, 0x7c, 0xfe, 0x62, 0x73, 0xe1, 0x8f, 0x2f, 0x36, 0x17, 0x3e, 0x7a, 0x37, 0x57,
+	0x0f, 0xfb, 0xfa, 0x4f, 0x24, 0x3d, 0xf0, 0x54, 0x3d, 0xf4, 0x43, 0x9f, 0x04, 0xfd, 0xa4, 0x50,
+	0x26, 0xd9, 0xff, 0x4b, 0xaa, 0x50, 0x7a, 0x4b, 0xea, 0x6f, 0xa1, 0xb7, 0xff, 0x33, 0x00, 0x0d,
+	0x04, 0xd1, 0x04, 0x7f, 0x12, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.BeansPerUnit) != len(that1.BeansPerUnit) {
+		return false
+	}
+	for i := range this.BeansPerUnit {
+		if !this.BeansPerUnit[i].Equal(&that1.BeansPerUnit[i]) {
+			return false
+		}
+	}
+	if len(this.FeeUnitPrice) != len(that1.FeeUnitPrice) {
+		return false
+	}
+	for i := range this.FeeUnitPrice {
+		if !this.FeeUnitPrice[i].Equal(&that1.FeeUnitPrice[i]) {
+			return false
 		}
 	}
 	if this.BootstrapVatConfig != that1.BootstrapVatConfig {
@@ -676,6 +1337,9 @@ func (this *Params) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !this.InboundPricing.Equal(&that1.InboundPricing) {
+		return false
+	}
 	return true
 }
 func (this *StringBeans) Equal(that interface{}) bool {
@@ -764,6 +1428,42 @@ func (this *QueueSize) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *InboundPricing) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*InboundPricing)
+	if !ok {
+		that2, ok := that.(InboundPricing)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if this.TargetQueuePercent != that1.TargetQueuePercent {
+		return false
+	}
+	if this.MaxChangePercent != that1.MaxChangePercent {
+		return false
+	}
+	if !this.MinBeans.Equal(that1.MinBeans) {
+		return false
+	}
+	if !this.MaxBeans.Equal(that1.MaxBeans) {
+		return false
+	}
+	return true
+}
 func (m *CoreEvalProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -872,6 +1572,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.InboundPricing.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSwingset(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	if len(m.QueueMax) > 0 {
 		for iNdEx := len(m.QueueMax) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1094,6 +1804,69 @@ func (m *QueueSize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *InboundPricing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InboundPricing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InboundPricing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxBeans.Size()
+		i -= size
+		if _, err := m.MaxBeans.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwingset(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MinBeans.Size()
+		i -= size
+		if _, err := m.MinBeans.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwingset(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.MaxChangePercent != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.MaxChangePercent))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TargetQueuePercent != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.TargetQueuePercent))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Egress) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1140,7 +1913,7 @@ func (m *Egress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *SwingStoreArtifact) Marshal() (dAtA []byte, err error) {
+func (m *ChargeRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1150,31 +1923,385 @@ func (m *SwingStoreArtifact) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SwingStoreArtifact) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChargeRecord) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SwingStoreArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ChargeRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x12
+	{
+		size := m.BeansOwing.Size()
+		i -= size
+		if _, err := m.BeansOwing.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwingset(dAtA, i, uint64(size))
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	i--
+	dAtA[i] = 0x32
+	if len(m.Debited) > 0 {
+		for iNdEx := len(m.Debited) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Debited[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwingset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	return len(dAtA) - i, nil
+	{
+		size := m.Beans.Size()
+		i -= size
+		if _, err := m.Beans.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwingset(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ChargeType) > 0 {
+		i -= len(m.ChargeType)
+		copy(dAtA[i:], m.ChargeType)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.ChargeType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ScheduledHeight != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.ScheduledHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Escrow) > 0 {
+		for iNdEx := len(m.Escrow) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Escrow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwingset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.NotBeforeTime != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.NotBeforeTime))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.NotBeforeHeight != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.NotBeforeHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.CoreEvals) > 0 {
+		for iNdEx := len(m.CoreEvals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CoreEvals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwingset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Spend {
+		i--
+		if m.Spend {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SwingStoreArtifact) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwingStoreArtifact) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwingStoreArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SwingStoreArtifactChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwingStoreArtifactChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwingStoreArtifactChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Sha256) > 0 {
+		i -= len(m.Sha256)
+		copy(dAtA[i:], m.Sha256)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Sha256)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Size_ != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Compression != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.Compression))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Last {
+		i--
+		if m.Last {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Index != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InstalledBundle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InstalledBundle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InstalledBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Size_ != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BundleUpload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BundleUpload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BundleUpload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.BytesReceived != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.BytesReceived))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ChunksReceived != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.ChunksReceived))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.UncompressedSize != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.UncompressedSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Size_ != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Sha512) > 0 {
+		i -= len(m.Sha512)
+		copy(dAtA[i:], m.Sha512)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Sha512)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
 func encodeVarintSwingset(dAtA []byte, offset int, v uint64) int {
@@ -1262,6 +2389,8 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovSwingset(uint64(l))
 		}
 	}
+	l = m.InboundPricing.Size()
+	n += 1 + l + sovSwingset(uint64(l))
 	return n
 }
 
@@ -1330,6 +2459,28 @@ func (m *QueueSize) Size() (n int) {
 	return n
 }
 
+func (m *InboundPricing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.TargetQueuePercent != 0 {
+		n += 1 + sovSwingset(uint64(m.TargetQueuePercent))
+	}
+	if m.MaxChangePercent != 0 {
+		n += 1 + sovSwingset(uint64(m.MaxChangePercent))
+	}
+	l = m.MinBeans.Size()
+	n += 1 + l + sovSwingset(uint64(l))
+	l = m.MaxBeans.Size()
+	n += 1 + l + sovSwingset(uint64(l))
+	return n
+}
+
 func (m *Egress) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1350,33 +2501,1304 @@ func (m *Egress) Size() (n int) {
 			n += 1 + l + sovSwingset(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *ChargeRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovSwingset(uint64(m.BlockHeight))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	l = len(m.ChargeType)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	l = m.Beans.Size()
+	n += 1 + l + sovSwingset(uint64(l))
+	if len(m.Debited) > 0 {
+		for _, e := range m.Debited {
+			l = e.Size()
+			n += 1 + l + sovSwingset(uint64(l))
+		}
+	}
+	l = m.BeansOwing.Size()
+	n += 1 + l + sovSwingset(uint64(l))
+	return n
+}
+
+func (m *ScheduledAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSwingset(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	if m.Spend {
+		n += 2
+	}
+	if len(m.CoreEvals) > 0 {
+		for _, e := range m.CoreEvals {
+			l = e.Size()
+			n += 1 + l + sovSwingset(uint64(l))
+		}
+	}
+	if m.NotBeforeHeight != 0 {
+		n += 1 + sovSwingset(uint64(m.NotBeforeHeight))
+	}
+	if m.NotBeforeTime != 0 {
+		n += 1 + sovSwingset(uint64(m.NotBeforeTime))
+	}
+	if len(m.Escrow) > 0 {
+		for _, e := range m.Escrow {
+			l = e.Size()
+			n += 1 + l + sovSwingset(uint64(l))
+		}
+	}
+	if m.ScheduledHeight != 0 {
+		n += 1 + sovSwingset(uint64(m.ScheduledHeight))
+	}
+	return n
+}
+
+func (m *SwingStoreArtifact) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	return n
+}
+
+func (m *SwingStoreArtifactChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovSwingset(uint64(m.Index))
+	}
+	if m.Last {
+		n += 2
+	}
+	if m.Compression != 0 {
+		n += 1 + sovSwingset(uint64(m.Compression))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovSwingset(uint64(m.Size_))
+	}
+	l = len(m.Sha256)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	return n
+}
+
+func (m *InstalledBundle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovSwingset(uint64(m.Size_))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovSwingset(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *BundleUpload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSwingset(uint64(m.Id))
+	}
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	l = len(m.Sha512)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovSwingset(uint64(m.Size_))
+	}
+	if m.UncompressedSize != 0 {
+		n += 1 + sovSwingset(uint64(m.UncompressedSize))
+	}
+	if m.ChunksReceived != 0 {
+		n += 1 + sovSwingset(uint64(m.ChunksReceived))
+	}
+	if m.BytesReceived != 0 {
+		n += 1 + sovSwingset(uint64(m.BytesReceived))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovSwingset(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func sovSwingset(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSwingset(x uint64) (n int) {
+	return sovSwingset(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CoreEvalProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CoreEvalProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CoreEvalProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Evals = append(m.Evals, CoreEval{})
+			if err := m.Evals[len(m.Evals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CoreEval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CoreEval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CoreEval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JsonPermits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JsonPermits = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JsCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JsCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeansPerUnit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeansPerUnit = append(m.BeansPerUnit, StringBeans{})
+			if err := m.BeansPerUnit[len(m.BeansPerUnit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeUnitPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeUnitPrice = append(m.FeeUnitPrice, types.Coin{})
+			if err := m.FeeUnitPrice[len(m.FeeUnitPrice)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BootstrapVatConfig", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BootstrapVatConfig = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerFlagFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PowerFlagFees = append(m.PowerFlagFees, PowerFlagFee{})
+			if err := m.PowerFlagFees[len(m.PowerFlagFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueMax", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueueMax = append(m.QueueMax, QueueSize{})
+			if err := m.QueueMax[len(m.QueueMax)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundPricing", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InboundPricing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *State) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: State: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: State: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueAllowed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueueAllowed = append(m.QueueAllowed, QueueSize{})
+			if err := m.QueueAllowed[len(m.QueueAllowed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StringBeans) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StringBeans: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StringBeans: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beans", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Beans.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PowerFlagFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PowerFlagFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PowerFlagFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerFlag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PowerFlag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueueSize) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueueSize: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueueSize: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InboundPricing) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InboundPricing: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InboundPricing: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetQueuePercent", wireType)
+			}
+			m.TargetQueuePercent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetQueuePercent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangePercent", wireType)
+			}
+			m.MaxChangePercent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxChangePercent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBeans", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBeans.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBeans", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBeans.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *SwingStoreArtifact) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSwingset(uint64(l))
-	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovSwingset(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovSwingset(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozSwingset(x uint64) (n int) {
-	return sovSwingset(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *CoreEvalProposal) Unmarshal(dAtA []byte) error {
+func (m *Egress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1399,15 +3821,15 @@ func (m *CoreEvalProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CoreEvalProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: Egress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CoreEvalProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Egress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nickname", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1435,13 +3857,13 @@ func (m *CoreEvalProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Nickname = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwingset
@@ -1451,29 +3873,31 @@ func (m *CoreEvalProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthSwingset
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwingset
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Peer = append(m.Peer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Peer == nil {
+				m.Peer = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Evals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerFlags", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwingset
@@ -1483,25 +3907,23 @@ func (m *CoreEvalProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSwingset
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwingset
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Evals = append(m.Evals, CoreEval{})
-			if err := m.Evals[len(m.Evals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PowerFlags = append(m.PowerFlags, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1524,7 +3946,7 @@ func (m *CoreEvalProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CoreEval) Unmarshal(dAtA []byte) error {
+func (m *ChargeRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1547,15 +3969,34 @@ func (m *CoreEval) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CoreEval: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChargeRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CoreEval: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChargeRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JsonPermits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1583,11 +4024,43 @@ func (m *CoreEval) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JsonPermits = string(dAtA[iNdEx:postIndex])
+			m.TxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JsCode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChargeType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChargeType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beans", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1615,7 +4088,77 @@ func (m *CoreEval) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JsCode = string(dAtA[iNdEx:postIndex])
+			if err := m.Beans.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Debited", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Debited = append(m.Debited, types.Coin{})
+			if err := m.Debited[len(m.Debited)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeansOwing", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BeansOwing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1638,7 +4181,7 @@ func (m *CoreEval) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *ScheduledAction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1661,17 +4204,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduledAction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduledAction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BeansPerUnit", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwingset
@@ -1681,31 +4224,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthSwingset
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSwingset
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BeansPerUnit = append(m.BeansPerUnit, StringBeans{})
-			if err := m.BeansPerUnit[len(m.BeansPerUnit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeUnitPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwingset
@@ -1715,29 +4243,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthSwingset
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwingset
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeUnitPrice = append(m.FeeUnitPrice, types.Coin{})
-			if err := m.FeeUnitPrice[len(m.FeeUnitPrice)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BootstrapVatConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1765,11 +4293,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BootstrapVatConfig = string(dAtA[iNdEx:postIndex])
+			m.Action = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spend", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Spend = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PowerFlagFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CoreEvals", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1796,14 +4344,52 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PowerFlagFees = append(m.PowerFlagFees, PowerFlagFee{})
-			if err := m.PowerFlagFees[len(m.PowerFlagFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CoreEvals = append(m.CoreEvals, CoreEval{})
+			if err := m.CoreEvals[len(m.CoreEvals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotBeforeHeight", wireType)
+			}
+			m.NotBeforeHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NotBeforeHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotBeforeTime", wireType)
+			}
+			m.NotBeforeTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NotBeforeTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueueMax", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1830,11 +4416,30 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QueueMax = append(m.QueueMax, QueueSize{})
-			if err := m.QueueMax[len(m.QueueMax)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Escrow = append(m.Escrow, types.Coin{})
+			if err := m.Escrow[len(m.Escrow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledHeight", wireType)
+			}
+			m.ScheduledHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSwingset(dAtA[iNdEx:])
@@ -1856,7 +4461,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *State) Unmarshal(dAtA []byte) error {
+func (m *SwingStoreArtifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1879,17 +4484,17 @@ func (m *State) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: State: wiretype end group for non-group")
+			return fmt.Errorf("proto: SwingStoreArtifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: State: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SwingStoreArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueueAllowed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwingset
@@ -1899,24 +4504,56 @@ func (m *State) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSwingset
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwingset
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QueueAllowed = append(m.QueueAllowed, QueueSize{})
-			if err := m.QueueAllowed[len(m.QueueAllowed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -1940,7 +4577,7 @@ func (m *State) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StringBeans) Unmarshal(dAtA []byte) error {
+func (m *SwingStoreArtifactChunk) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1963,15 +4600,15 @@ func (m *StringBeans) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StringBeans: wiretype end group for non-group")
+			return fmt.Errorf("proto: SwingStoreArtifactChunk: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StringBeans: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SwingStoreArtifactChunk: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1999,13 +4636,13 @@ func (m *StringBeans) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Beans", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var stringLen uint64
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwingset
@@ -2015,81 +4652,74 @@ func (m *StringBeans) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Index |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSwingset
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSwingset
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Beans.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSwingset(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Last", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSwingset
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.Last = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PowerFlagFee) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSwingset
+			m.Compression = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Compression |= SwingStoreArtifactChunk_Compression(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PowerFlagFee: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PowerFlagFee: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PowerFlag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sha256", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwingset
@@ -2099,29 +4729,31 @@ func (m *PowerFlagFee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthSwingset
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwingset
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PowerFlag = string(dAtA[iNdEx:postIndex])
+			m.Sha256 = append(m.Sha256[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sha256 == nil {
+				m.Sha256 = []byte{}
+			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwingset
@@ -2131,24 +4763,24 @@ func (m *PowerFlagFee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthSwingset
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwingset
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fee = append(m.Fee, types.Coin{})
-			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -2172,7 +4804,7 @@ func (m *PowerFlagFee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueueSize) Unmarshal(dAtA []byte) error {
+func (m *InstalledBundle) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2195,15 +4827,15 @@ func (m *QueueSize) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueueSize: wiretype end group for non-group")
+			return fmt.Errorf("proto: InstalledBundle: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueueSize: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InstalledBundle: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2231,9 +4863,43 @@ func (m *QueueSize) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = append(m.Submitter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Submitter == nil {
+				m.Submitter = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
 			}
@@ -2247,7 +4913,26 @@ func (m *QueueSize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Size_ |= int32(b&0x7F) << shift
+				m.Size_ |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2273,7 +4958,7 @@ func (m *QueueSize) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Egress) Unmarshal(dAtA []byte) error {
+func (m *BundleUpload) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2296,17 +4981,17 @@ func (m *Egress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Egress: wiretype end group for non-group")
+			return fmt.Errorf("proto: BundleUpload: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Egress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BundleUpload: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nickname", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwingset
@@ -2316,27 +5001,14 @@ func (m *Egress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSwingset
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSwingset
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Nickname = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2363,14 +5035,14 @@ func (m *Egress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Peer = append(m.Peer[:0], dAtA[iNdEx:postIndex]...)
-			if m.Peer == nil {
-				m.Peer = []byte{}
+			m.Submitter = append(m.Submitter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Submitter == nil {
+				m.Submitter = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PowerFlags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sha512", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2398,63 +5070,13 @@ func (m *Egress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PowerFlags = append(m.PowerFlags, string(dAtA[iNdEx:postIndex]))
+			m.Sha512 = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSwingset(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSwingset
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SwingStoreArtifact) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSwingset
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SwingStoreArtifact: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SwingStoreArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
 			}
-			var stringLen uint64
+			m.Size_ = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwingset
@@ -2464,29 +5086,35 @@ func (m *SwingStoreArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Size_ |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSwingset
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSwingset
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UncompressedSize", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.UncompressedSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UncompressedSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunksReceived", wireType)
 			}
-			var byteLen int
+			m.ChunksReceived = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwingset
@@ -2496,26 +5124,49 @@ func (m *SwingStoreArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.ChunksReceived |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthSwingset
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesReceived", wireType)
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSwingset
+			m.BytesReceived = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesReceived |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSwingset(dAtA[iNdEx:])
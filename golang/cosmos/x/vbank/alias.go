@@ -12,9 +12,10 @@ const (
 )
 
 var (
-	NewKeeper     = keeper.NewKeeper
-	ModuleCdc     = types.ModuleCdc
-	RegisterCodec = types.RegisterCodec
+	NewKeeper        = keeper.NewKeeper
+	NewMsgServerImpl = keeper.NewMsgServerImpl
+	ModuleCdc        = types.ModuleCdc
+	RegisterCodec    = types.RegisterCodec
 )
 
 type (
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	sdkioerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the vbank MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (keeper msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if authority := keeper.GetAuthority(); !msg.Authority.Equals(authority) {
+		return nil, sdkioerrors.Wrapf(sdkerrors.ErrUnauthorized, "expected authority %s, got %s", authority, msg.Authority)
+	}
+	if err := msg.Params.ValidateBasic(); err != nil {
+		return nil, sdkioerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	keeper.SetParams(ctx, msg.Params)
+	return &types.MsgUpdateParamsResponse{}, nil
+}
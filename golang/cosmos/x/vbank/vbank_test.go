@@ -816,3 +816,34 @@ func Test_Module_Account(t *testing.T) {
 		t.Errorf("got IsModuleAccount missingAddr = false, want true")
 	}
 }
+
+func Test_MsgUpdateParams(t *testing.T) {
+	keeper, ctx := makeTestKit(nil, nil)
+	msgServer := NewMsgServerImpl(keeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	newParams := types.DefaultParams()
+	newParams.RewardEpochDurationBlocks = 10
+	newParams.PerEpochRewardFraction = sdk.NewDecWithPrec(5, 2)
+
+	notAuthority := sdk.AccAddress([]byte("not the authority"))
+	_, err := msgServer.UpdateParams(goCtx, types.NewMsgUpdateParams(notAuthority, newParams))
+	if err == nil {
+		t.Errorf("params updated by non-authority")
+	}
+
+	invalidParams := newParams
+	invalidParams.RewardEpochDurationBlocks = -1
+	_, err = msgServer.UpdateParams(goCtx, types.NewMsgUpdateParams(keeper.GetAuthority(), invalidParams))
+	if err == nil {
+		t.Errorf("invalid params accepted")
+	}
+
+	_, err = msgServer.UpdateParams(goCtx, types.NewMsgUpdateParams(keeper.GetAuthority(), newParams))
+	if err != nil {
+		t.Fatalf("UpdateParams error: %v", err)
+	}
+	if got := keeper.GetParams(ctx); !got.Equal(newParams) {
+		t.Errorf("got params %v, want %v", got, newParams)
+	}
+}
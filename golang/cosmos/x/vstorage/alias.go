@@ -6,15 +6,20 @@ import (
 )
 
 const (
-	ModuleName = types.ModuleName
-	StoreKey   = types.StoreKey
+	ModuleName   = types.ModuleName
+	StoreKey     = types.StoreKey
+	MetaStoreKey = types.MetaStoreKey
+
+	DefaultWatchRetainBlocks = keeper.DefaultWatchRetainBlocks
 )
 
 var (
-	NewKeeper   = keeper.NewKeeper
-	NewQuerier  = keeper.NewQuerier
-	NewStorage  = types.NewData
-	NewChildren = types.NewChildren
+	NewKeeper            = keeper.NewKeeper
+	NewQuerier           = keeper.NewQuerier
+	NewStorage           = types.NewData
+	NewChildren          = types.NewChildren
+	NewStreamCellHistory = keeper.NewStreamCellHistory
+	NewChangeFeed        = keeper.NewChangeFeed
 )
 
 type (
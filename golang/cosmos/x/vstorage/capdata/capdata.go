@@ -40,6 +40,39 @@ type CapdataRemotable struct {
 	Id             interface{}
 	Iface          *string
 	Representation interface{}
+	// IsPromise distinguishes a promise from other Remotables (which is only
+	// possible in smallcaps encoding).
+	IsPromise bool
+}
+
+// CapdataSpecialNumber is a number that JSON cannot represent, named "NaN",
+// "Infinity", or "-Infinity".
+type CapdataSpecialNumber struct {
+	Name string
+}
+
+// CapdataUndefined is the JavaScript `undefined` value.
+type CapdataUndefined struct{}
+
+// CapdataSymbol is a registered or well-known symbol, the latter named with
+// an "@@" prefix (e.g., "@@asyncIterator").
+type CapdataSymbol struct {
+	Name string
+}
+
+// CapdataTagged is a tagged value such as a copySet or copyBag, consisting of
+// a tag string and an arbitrary payload.
+type CapdataTagged struct {
+	Tag     string
+	Payload interface{}
+}
+
+// CapdataError is a passable error, with any optional properties beyond its
+// name and message (such as "errorId" or "cause") in Extra.
+type CapdataError struct {
+	Name    string
+	Message string
+	Extra   map[string]interface{}
 }
 
 func NewCapdataBigint(str string) *CapdataBigint {
@@ -54,9 +87,69 @@ func (r *CapdataRemotable) MarshalJSON() ([]byte, error) {
 	return JsonMarshal(r.Representation)
 }
 
+// CapdataValueTransformations specify how to represent each kind of value
+// that has no JSON equivalent.  Decoding fails upon encountering a value of a
+// kind that has no transformation.
 type CapdataValueTransformations struct {
-	Bigint    func(*CapdataBigint) interface{}
-	Remotable func(*CapdataRemotable) interface{}
+	Bigint        func(*CapdataBigint) interface{}
+	Remotable     func(*CapdataRemotable) interface{}
+	SpecialNumber func(*CapdataSpecialNumber) interface{}
+	Undefined     func(*CapdataUndefined) interface{}
+	Symbol        func(*CapdataSymbol) interface{}
+	Tagged        func(*CapdataTagged) interface{}
+	Error         func(*CapdataError) interface{}
+}
+
+func (t CapdataValueTransformations) specialNumber(name string) (interface{}, error) {
+	if t.SpecialNumber == nil {
+		return nil, fmt.Errorf("untransformed special number")
+	}
+	return t.SpecialNumber(&CapdataSpecialNumber{Name: name}), nil
+}
+
+func (t CapdataValueTransformations) undefined() (interface{}, error) {
+	if t.Undefined == nil {
+		return nil, fmt.Errorf("untransformed undefined")
+	}
+	return t.Undefined(&CapdataUndefined{}), nil
+}
+
+func (t CapdataValueTransformations) symbol(name string) (interface{}, error) {
+	if t.Symbol == nil {
+		return nil, fmt.Errorf("untransformed symbol")
+	}
+	return t.Symbol(&CapdataSymbol{Name: name}), nil
+}
+
+func (t CapdataValueTransformations) tagged(tag interface{}, payload interface{}) (interface{}, error) {
+	tagStr, ok := tag.(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid tag: %q", tag)
+	}
+	if t.Tagged == nil {
+		return nil, fmt.Errorf("untransformed tagged")
+	}
+	return t.Tagged(&CapdataTagged{Tag: tagStr, Payload: payload}), nil
+}
+
+// errorValue transforms a decoded error record, in which "name" and "message"
+// are required strings and any other properties are extra.
+func (t CapdataValueTransformations) errorValue(props map[string]interface{}) (interface{}, error) {
+	name, nameOk := props["name"].(string)
+	message, messageOk := props["message"].(string)
+	if !nameOk || !messageOk {
+		return nil, fmt.Errorf("invalid error: name %q, message %q", props["name"], props["message"])
+	}
+	if t.Error == nil {
+		return nil, fmt.Errorf("untransformed error")
+	}
+	extra := make(map[string]interface{}, len(props))
+	for k, v := range props {
+		if k != "name" && k != "message" {
+			extra[k] = v
+		}
+	}
+	return t.Error(&CapdataError{Name: name, Message: message, Extra: extra}), nil
 }
 
 // upsertCapdataRemotable either adds a new CapdataRemotable to `remotables` at the specified
@@ -131,22 +224,58 @@ func decodeCapdataLegacyValue(
 					return nil, fmt.Errorf("invalid slot iface: %q", ifaceVal)
 				}
 				return upsertCapdataRemotable(remotables, slotIndex, slots[slotIndex], iface)
-			case "hilbert":
-				fallthrough
 			case "undefined":
-				fallthrough
-			case "NaN":
-				fallthrough
-			case "Infinity":
-				fallthrough
+				return transformations.undefined()
+			case "NaN", "Infinity", "-Infinity":
+				return transformations.specialNumber(qclass)
 			case "symbol":
-				fallthrough
+				name, ok := obj["name"].(string)
+				if !ok {
+					return nil, fmt.Errorf("invalid symbol name: %q", obj["name"])
+				}
+				return transformations.symbol(name)
 			case "tagged":
-				fallthrough
+				payload, err := decodeCapdataLegacyValue(obj["payload"], slots, remotables, transformations)
+				if err != nil {
+					return nil, err
+				}
+				return transformations.tagged(obj["tag"], payload)
 			case "error":
-				fallthrough
-			case "-Infinity":
-				return nil, fmt.Errorf("not implemented: @qclass %q", qclass)
+				props := make(map[string]interface{}, len(obj))
+				for k, v := range obj {
+					if k == "@qclass" {
+						continue
+					}
+					decoded, err := decodeCapdataLegacyValue(v, slots, remotables, transformations)
+					if err != nil {
+						return nil, err
+					}
+					props[k] = decoded
+				}
+				return transformations.errorValue(props)
+			case "hilbert":
+				// A record with its own "@qclass" property.
+				original, err := decodeCapdataLegacyValue(obj["original"], slots, remotables, transformations)
+				if err != nil {
+					return nil, err
+				}
+				decodedObj := map[string]interface{}{}
+				if restVal, ok := obj["rest"]; ok {
+					rest, err := decodeCapdataLegacyValue(restVal, slots, remotables, transformations)
+					if err != nil {
+						return nil, err
+					}
+					restObj, ok := rest.(map[string]interface{})
+					if !ok {
+						return nil, fmt.Errorf("invalid hilbert rest: %q", restVal)
+					}
+					if _, ok := restObj["@qclass"]; ok {
+						return nil, fmt.Errorf("invalid hilbert rest with @qclass")
+					}
+					decodedObj = restObj
+				}
+				decodedObj["@qclass"] = original
+				return decodedObj, nil
 			default:
 				return nil, fmt.Errorf("unrecognized @qclass: %q", qclass)
 			}
@@ -182,11 +311,35 @@ func decodeCapdataSmallcapsValue(
 		}
 		return arr, nil
 	} else if encodedObj, ok := encoded.(map[string]interface{}); ok {
-		if _, ok := encodedObj["#tag"]; ok {
-			return nil, fmt.Errorf("not implemented: #tag")
+		if tag, ok := encodedObj["#tag"]; ok {
+			if len(encodedObj) != 2 {
+				return nil, fmt.Errorf("invalid tagged record")
+			}
+			payload, err := decodeCapdataSmallcapsValue(encodedObj["payload"], slots, remotables, transformations)
+			if err != nil {
+				return nil, err
+			}
+			return transformations.tagged(tag, payload)
 		}
-		if _, ok := encodedObj["#error"]; ok {
-			return nil, fmt.Errorf("not implemented: #error")
+		if message, ok := encodedObj["#error"]; ok {
+			props := make(map[string]interface{}, len(encodedObj))
+			for k, v := range encodedObj {
+				if k == "#error" {
+					// Like the name, the message is an encoded string.
+					decodedMessage, err := decodeCapdataSmallcapsValue(message, slots, remotables, CapdataValueTransformations{})
+					if err != nil {
+						return nil, err
+					}
+					props["message"] = decodedMessage
+					continue
+				}
+				decoded, err := decodeCapdataSmallcapsValue(v, slots, remotables, transformations)
+				if err != nil {
+					return nil, err
+				}
+				props[k] = decoded
+			}
+			return transformations.errorValue(props)
 		}
 		// We need a distinct output map to avoid reprocessing already-decoded keys.
 		decodedObj := make(map[string]interface{}, len(encodedObj))
@@ -226,10 +379,11 @@ func decodeCapdataSmallcapsValue(
 				return nil, fmt.Errorf("untransformed bigint")
 			}
 			return transformations.Bigint(bigint), nil
-		case '$':
+		case '$', '&':
 			var slotIndexStr string
 			var iface *string
-			if dotIndex := strings.IndexByte(str, '.'); dotIndex >= 0 {
+			// Promises have no iface.
+			if dotIndex := strings.IndexByte(str, '.'); dotIndex >= 0 && str[0] == '$' {
 				slotIndexStr = str[1:dotIndex]
 				ifaceStr := str[dotIndex+1:]
 				iface = &ifaceStr
@@ -244,13 +398,20 @@ func decodeCapdataSmallcapsValue(
 			if err != nil {
 				return nil, fmt.Errorf("slot iface mismatch: %q", str)
 			}
+			if str[0] == '&' {
+				r.IsPromise = true
+			}
 			return r, nil
 		case '#':
-			fallthrough
+			switch str[1:] {
+			case "undefined":
+				return transformations.undefined()
+			case "NaN", "Infinity", "-Infinity":
+				return transformations.specialNumber(str[1:])
+			}
+			return nil, fmt.Errorf("unrecognized special value: %q", str)
 		case '%':
-			fallthrough
-		case '&':
-			return nil, fmt.Errorf("not implemented: %q", str)
+			return transformations.symbol(str[1:])
 		default:
 			if str[0] >= '!' && str[0] <= '-' {
 				return nil, fmt.Errorf("invalid smallcaps encoding prefix: %q", str[:1])
@@ -35,9 +35,32 @@ func remotableToString(r *CapdataRemotable) interface{} {
 	if r.Iface != nil {
 		iface = *r.Iface
 	}
+	if r.IsPromise {
+		return fmt.Sprintf("promise:%s{%s}", iface, r.Id)
+	}
 	return fmt.Sprintf("remotable:%s{%s}", iface, r.Id)
 }
 
+// specialTransformations represent every kind of value that has no JSON
+// equivalent.
+var specialTransformations = CapdataValueTransformations{
+	Bigint:    prefixBigint,
+	Remotable: remotableToString,
+	SpecialNumber: func(n *CapdataSpecialNumber) interface{} {
+		return fmt.Sprintf("number:%s", n.Name)
+	},
+	Undefined: func(*CapdataUndefined) interface{} { return "undefined" },
+	Symbol: func(s *CapdataSymbol) interface{} {
+		return fmt.Sprintf("symbol:%s", s.Name)
+	},
+	Tagged: func(t *CapdataTagged) interface{} {
+		return map[string]interface{}{"tag": t.Tag, "payload": t.Payload}
+	},
+	Error: func(e *CapdataError) interface{} {
+		return map[string]interface{}{"name": e.Name, "message": e.Message, "extra": e.Extra}
+	},
+}
+
 func Test_JsonMarshal(t *testing.T) {
 	type testCase struct {
 		input       string
@@ -67,243 +90,315 @@ func Test_JsonMarshal(t *testing.T) {
 	}
 }
 
-func Test_DecodeSerializedCapdata(t *testing.T) {
-	type testCase struct {
-		format          string
-		label           string
-		body            string
-		slots           []interface{}
-		expected        string
-		errContains     *string
-		transformations CapdataValueTransformations
-	}
-	testCases := []testCase{
-		// JSON
-		// cf. https://github.com/endojs/endo/blob/209b612e0a267239f33cd607e94ccd179d3ba248/packages/marshal/test/test-marshal-capdata.js#L11
-		{body: `[1, 2]`},
-		{body: `{ "foo": 1 }`},
-		{body: `{}`},
-		{body: `{ "a": 1, "b": 2 }`},
-		{body: `{ "a": 1, "b": { "c": 3 } }`},
-		{body: `true`},
-		{body: `1`},
-		{body: `"abc"`},
-		{body: `null`},
+type decodingTestCase struct {
+	format          string
+	label           string
+	body            string
+	slots           []interface{}
+	expected        string
+	errContains     *string
+	transformations CapdataValueTransformations
+}
 
-		// transformation of non-JSON values
-		{format: "smallcaps", label: "bigint",
-			body:            `"+98765432101234567890"`,
-			expected:        `"bigint:98765432101234567890"`,
-			transformations: CapdataValueTransformations{Bigint: prefixBigint},
-		},
-		{format: "legacy", label: "bigint",
-			body:            `{ "@qclass": "bigint", "digits": "98765432101234567890" }`,
-			expected:        `"bigint:98765432101234567890"`,
-			transformations: CapdataValueTransformations{Bigint: prefixBigint},
-		},
-		{format: "smallcaps", label: "remotables",
-			body:            `["$0.Foo", "$0"]`,
-			slots:           []interface{}{"a"},
-			expected:        `["remotable:Foo{a}","remotable:Foo{a}"]`,
-			transformations: CapdataValueTransformations{Remotable: remotableToString},
-		},
-		{format: "legacy", label: "remotables",
-			body:            `[{"@qclass":"slot","index":0,"iface":"Foo"}, {"@qclass":"slot","index":0}]`,
-			slots:           []interface{}{"a"},
-			expected:        `["remotable:Foo{a}","remotable:Foo{a}"]`,
-			transformations: CapdataValueTransformations{Remotable: remotableToString},
-		},
-		{format: "smallcaps", label: "escaped string",
-			body:     `"!#escaped"`,
-			expected: `"#escaped"`,
-		},
+// decodingTestCases are shared by the tests of decoding and encoding.
+var decodingTestCases = []decodingTestCase{
+	// JSON
+	// cf. https://github.com/endojs/endo/blob/209b612e0a267239f33cd607e94ccd179d3ba248/packages/marshal/test/test-marshal-capdata.js#L11
+	{body: `[1, 2]`},
+	{body: `{ "foo": 1 }`},
+	{body: `{}`},
+	{body: `{ "a": 1, "b": 2 }`},
+	{body: `{ "a": 1, "b": { "c": 3 } }`},
+	{body: `true`},
+	{body: `1`},
+	{body: `"abc"`},
+	{body: `null`},
 
-		// unimplemented
-		{format: "smallcaps",
-			body:        `"#undefined"`,
-			errContains: ptr("not implemented"),
-		},
-		{format: "legacy", label: "undefined",
-			body:        `{"@qclass":"undefined"}`,
-			errContains: ptr("not implemented"),
-		},
-		{format: "smallcaps",
-			body:        `"#NaN"`,
-			errContains: ptr("not implemented"),
-		},
-		{format: "legacy", label: "NaN",
-			body:        `{"@qclass":"NaN"}`,
-			errContains: ptr("not implemented"),
-		},
-		{format: "smallcaps",
-			body:        `"#Infinity"`,
-			errContains: ptr("not implemented"),
-		},
-		{format: "legacy", label: "Infinity",
-			body:        `{"@qclass":"Infinity"}`,
-			errContains: ptr("not implemented"),
-		},
-		{format: "smallcaps",
-			body:        `"#-Infinity"`,
-			errContains: ptr("not implemented"),
-		},
-		{format: "legacy", label: "-Infinity",
-			body:        `{"@qclass":"-Infinity"}`,
-			errContains: ptr("not implemented"),
-		},
-		{format: "smallcaps", label: "symbol",
-			body:        `"%foo"`,
-			errContains: ptr("not implemented"),
-		},
-		{format: "legacy", label: "symbol",
-			body:        `{"@qclass":"symbol"}`,
-			errContains: ptr("not implemented"),
-		},
-		{format: "smallcaps", label: "tagged",
-			body:        `{"#tag":"foo","payload":"bar"}`,
-			errContains: ptr("not implemented: #tag"),
-		},
-		{format: "legacy", label: "tagged",
-			body:        `{"@qclass":"tagged","tag":"foo","payload":"bar"}`,
-			errContains: ptr("not implemented"),
-		},
-		{format: "smallcaps", label: "error",
-			body:        `{"#error":"","name":"Error"}`,
-			errContains: ptr("not implemented: #error"),
-		},
-		{format: "legacy", label: "error",
-			body:        `{"@qclass":"error","message":"foo","name":"bar"}`,
-			errContains: ptr("not implemented"),
-		},
-		{format: "smallcaps", label: "promise",
-			body:        `"&0"`,
-			slots:       []interface{}{"a"},
-			errContains: ptr("not implemented"),
-		},
-		{format: "legacy", label: "error",
-			body:        `{"@qclass":"hilbert","original":"foo"}`,
-			errContains: ptr("not implemented"),
-		},
+	// transformation of non-JSON values
+	{format: "smallcaps", label: "bigint",
+		body:            `"+98765432101234567890"`,
+		expected:        `"bigint:98765432101234567890"`,
+		transformations: CapdataValueTransformations{Bigint: prefixBigint},
+	},
+	{format: "legacy", label: "bigint",
+		body:            `{ "@qclass": "bigint", "digits": "98765432101234567890" }`,
+		expected:        `"bigint:98765432101234567890"`,
+		transformations: CapdataValueTransformations{Bigint: prefixBigint},
+	},
+	{format: "smallcaps", label: "remotables",
+		body:            `["$0.Foo", "$0"]`,
+		slots:           []interface{}{"a"},
+		expected:        `["remotable:Foo{a}","remotable:Foo{a}"]`,
+		transformations: CapdataValueTransformations{Remotable: remotableToString},
+	},
+	{format: "legacy", label: "remotables",
+		body:            `[{"@qclass":"slot","index":0,"iface":"Foo"}, {"@qclass":"slot","index":0}]`,
+		slots:           []interface{}{"a"},
+		expected:        `["remotable:Foo{a}","remotable:Foo{a}"]`,
+		transformations: CapdataValueTransformations{Remotable: remotableToString},
+	},
+	{format: "smallcaps", label: "escaped string",
+		body:     `"!#escaped"`,
+		expected: `"#escaped"`,
+	},
 
-		// missing transformations
-		{format: "smallcaps", label: "untransformed bigint",
-			body:        `"+98765432101234567890"`,
-			errContains: ptr("untransformed bigint"),
-		},
-		{format: "legacy", label: "untransformed bigint",
-			body:        `{ "@qclass": "bigint", "digits": "98765432101234567890" }`,
-			errContains: ptr("untransformed bigint"),
-		},
-		{format: "smallcaps", label: "untransformed remotable",
-			body:        `["$0.Foo", "$0"]`,
-			slots:       []interface{}{"a"},
-			errContains: ptr("untransformed remotable"),
-		},
-		{format: "legacy", label: "untransformed remotable",
-			body:        `[{"@qclass":"slot","index":0,"iface":"Foo"}, {"@qclass":"slot","index":0}]`,
-			slots:       []interface{}{"a"},
-			errContains: ptr("untransformed remotable"),
-		},
+	// special values
+	{format: "smallcaps",
+		body:            `"#undefined"`,
+		expected:        `"undefined"`,
+		transformations: specialTransformations,
+	},
+	{format: "legacy", label: "undefined",
+		body:            `{"@qclass":"undefined"}`,
+		expected:        `"undefined"`,
+		transformations: specialTransformations,
+	},
+	{format: "smallcaps",
+		body:            `["#NaN","#Infinity","#-Infinity"]`,
+		expected:        `["number:NaN","number:Infinity","number:-Infinity"]`,
+		transformations: specialTransformations,
+	},
+	{format: "legacy", label: "special numbers",
+		body:            `[{"@qclass":"NaN"},{"@qclass":"Infinity"},{"@qclass":"-Infinity"}]`,
+		expected:        `["number:NaN","number:Infinity","number:-Infinity"]`,
+		transformations: specialTransformations,
+	},
+	{format: "smallcaps", label: "symbols",
+		body:            `["%foo","%@@asyncIterator"]`,
+		expected:        `["symbol:foo","symbol:@@asyncIterator"]`,
+		transformations: specialTransformations,
+	},
+	{format: "legacy", label: "symbols",
+		body:            `[{"@qclass":"symbol","name":"foo"},{"@qclass":"symbol","name":"@@asyncIterator"}]`,
+		expected:        `["symbol:foo","symbol:@@asyncIterator"]`,
+		transformations: specialTransformations,
+	},
+	{format: "smallcaps", label: "tagged",
+		body:            `{"#tag":"copySet","payload":["+1","$0.Foo"]}`,
+		slots:           []interface{}{"a"},
+		expected:        `{"tag":"copySet","payload":["bigint:1","remotable:Foo{a}"]}`,
+		transformations: specialTransformations,
+	},
+	{format: "legacy", label: "tagged",
+		body:            `{"@qclass":"tagged","tag":"copySet","payload":[{"@qclass":"bigint","digits":"1"},{"@qclass":"slot","index":0,"iface":"Foo"}]}`,
+		slots:           []interface{}{"a"},
+		expected:        `{"tag":"copySet","payload":["bigint:1","remotable:Foo{a}"]}`,
+		transformations: specialTransformations,
+	},
+	{format: "smallcaps", label: "error",
+		body:            `{"#error":"foo","name":"TypeError","errorId":"error:anon-vat#10001","cause":{"#error":"bar","name":"Error"}}`,
+		expected:        `{"name":"TypeError","message":"foo","extra":{"errorId":"error:anon-vat#10001","cause":{"name":"Error","message":"bar","extra":{}}}}`,
+		transformations: specialTransformations,
+	},
+	{format: "smallcaps", label: "error with escaped message",
+		body:            `{"#error":"!-1 is not positive","name":"RangeError"}`,
+		expected:        `{"name":"RangeError","message":"-1 is not positive","extra":{}}`,
+		transformations: specialTransformations,
+	},
+	{format: "legacy", label: "error",
+		body:            `{"@qclass":"error","message":"foo","name":"TypeError","errorId":"error:anon-vat#10001","cause":{"@qclass":"error","message":"bar","name":"Error"}}`,
+		expected:        `{"name":"TypeError","message":"foo","extra":{"errorId":"error:anon-vat#10001","cause":{"name":"Error","message":"bar","extra":{}}}}`,
+		transformations: specialTransformations,
+	},
+	{format: "smallcaps", label: "promises",
+		body:            `["&0","$1.Foo","&0"]`,
+		slots:           []interface{}{"a", "b"},
+		expected:        `["promise:{a}","remotable:Foo{b}","promise:{a}"]`,
+		transformations: specialTransformations,
+	},
+	{format: "legacy", label: "Hilbert Hotel",
+		body:     `{"@qclass":"hilbert","original":"foo","rest":{"a":1}}`,
+		expected: `{"@qclass":"foo","a":1}`,
+	},
+	{format: "legacy", label: "nested Hilbert Hotel",
+		body:     `{"@qclass":"hilbert","original":{"@qclass":"hilbert","original":"foo"}}`,
+		expected: `{"@qclass":{"@qclass":"foo"}}`,
+	},
 
-		// invalid data
-		{format: "smallcaps", label: "iface mismatch",
-			body:        `["$0.Foo", "$0."]`,
-			slots:       []interface{}{"a"},
-			errContains: ptr("iface mismatch"),
-		},
-		{format: "legacy", label: "iface mismatch",
-			body:        `[{"@qclass":"slot","index":0,"iface":"Foo"}, {"@qclass":"slot","index":0,"iface":""}]`,
-			slots:       []interface{}{"a"},
-			errContains: ptr("iface mismatch"),
-		},
-		{format: "smallcaps", label: "invalid slot index (out of bounds)",
-			body:        `"$0.Foo"`,
-			errContains: ptr("invalid slot index"),
-		},
-		{format: "legacy", label: "invalid slot index (out of bounds)",
-			body:        `{"@qclass":"slot","index":0}`,
-			errContains: ptr("invalid slot index"),
-		},
-		{format: "smallcaps", label: "invalid slot index (bad format)",
-			body:        `"$x.Foo"`,
-			slots:       []interface{}{"a"},
-			errContains: ptr("invalid slot index"),
-		},
-		{format: "legacy", label: "invalid slot index (missing)",
-			body:        `{"@qclass":"slot"}`,
-			slots:       []interface{}{"a"},
-			errContains: ptr("invalid slot index"),
-		},
-		{format: "legacy", label: "invalid slot index (null)",
-			body:        `{"@qclass":"slot","index":null}`,
-			slots:       []interface{}{"a"},
-			errContains: ptr("invalid slot index"),
-		},
-		{format: "legacy", label: "invalid slot index (string)",
-			body:        `{"@qclass":"slot","index":"0"}`,
-			slots:       []interface{}{"a"},
-			errContains: ptr("invalid slot index"),
-		},
-		{format: "legacy", label: "invalid slot index (non-integer)",
-			body:        `{"@qclass":"slot","index":0.1}`,
-			slots:       []interface{}{"a"},
-			errContains: ptr("invalid slot index"),
-		},
-		{format: "legacy", label: "invalid slot index (negative)",
-			body:        `{"@qclass":"slot","index":-1}`,
-			slots:       []interface{}{"a"},
-			errContains: ptr("invalid slot index"),
-		},
-		{format: "legacy", label: "invalid slot iface (number)",
-			body:        `{"@qclass":"slot","index":0,"iface":0}`,
-			slots:       []interface{}{"a"},
-			errContains: ptr("invalid slot iface"),
-		},
-		{format: "smallcaps", label: "unrecognized record type",
-			body:        `{"#foo":0}`,
-			errContains: ptr("unrecognized record type"),
-		},
-		{format: "legacy", label: "invalid @qclass (null)",
-			body:        `{"@qclass":null}`,
-			errContains: ptr("invalid @qclass"),
-		},
-		{format: "legacy", label: "invalid @qclass (number)",
-			body:        `{"@qclass":1}`,
-			errContains: ptr("invalid @qclass"),
-		},
-		{format: "legacy", label: "invalid @qclass (number)",
-			body:        `{"@qclass":1}`,
-			errContains: ptr("invalid @qclass"),
-		},
-		{format: "legacy", label: "unrecognized @qclass",
-			body:        `{"@qclass":"foo"}`,
-			errContains: ptr("unrecognized @qclass"),
-		},
-		{format: "smallcaps", label: "invalid copyRecord key",
-			body:        `{"+0":0}`,
-			errContains: ptr("invalid copyRecord key"),
-		},
-		{format: "smallcaps", label: "invalid bigint (`--`)",
-			body:        `"--"`,
-			errContains: ptr("invalid bigint"),
-		},
-		{format: "smallcaps", label: "invalid bigint (`+0x`)",
-			body:        `"+0x"`,
-			errContains: ptr("invalid bigint"),
-		},
-		{format: "legacy", label: "invalid bigint (no digits)",
-			body:        `{"@qclass":"bigint"}`,
-			errContains: ptr("invalid bigint"),
-		},
-		{format: "legacy", label: "invalid bigint (null digits)",
-			body:        `{"@qclass":"bigint","digits":null}`,
-			errContains: ptr("invalid bigint"),
-		},
-		{format: "legacy", label: "invalid bigint (`7up`)",
-			body:        `{"@qclass":"bigint","digits":"7up"}`,
-			errContains: ptr("invalid bigint"),
-		},
-	}
+	// invalid special values
+	{format: "smallcaps", label: "unrecognized special value",
+		body:            `"#foo"`,
+		errContains:     ptr("unrecognized special value"),
+		transformations: specialTransformations,
+	},
+	{format: "legacy", label: "symbol without name",
+		body:            `{"@qclass":"symbol"}`,
+		errContains:     ptr("invalid symbol name"),
+		transformations: specialTransformations,
+	},
+	{format: "smallcaps", label: "tagged with extra properties",
+		body:            `{"#tag":"copySet","payload":[],"extra":1}`,
+		errContains:     ptr("invalid tagged record"),
+		transformations: specialTransformations,
+	},
+	{format: "legacy", label: "tagged with non-string tag",
+		body:            `{"@qclass":"tagged","tag":1,"payload":[]}`,
+		errContains:     ptr("invalid tag"),
+		transformations: specialTransformations,
+	},
+	{format: "smallcaps", label: "error without name",
+		body:            `{"#error":"foo"}`,
+		errContains:     ptr("invalid error"),
+		transformations: specialTransformations,
+	},
+	{format: "legacy", label: "error with non-string message",
+		body:            `{"@qclass":"error","message":1,"name":"Error"}`,
+		errContains:     ptr("invalid error"),
+		transformations: specialTransformations,
+	},
+	{format: "legacy", label: "Hilbert Hotel with non-record rest",
+		body:        `{"@qclass":"hilbert","original":"foo","rest":[]}`,
+		errContains: ptr("invalid hilbert rest"),
+	},
+	{format: "smallcaps", label: "promise with iface",
+		body:            `"&0.Foo"`,
+		slots:           []interface{}{"a"},
+		errContains:     ptr("invalid slot index"),
+		transformations: specialTransformations,
+	},
+
+	// missing transformations
+	{format: "smallcaps", label: "untransformed bigint",
+		body:        `"+98765432101234567890"`,
+		errContains: ptr("untransformed bigint"),
+	},
+	{format: "legacy", label: "untransformed bigint",
+		body:        `{ "@qclass": "bigint", "digits": "98765432101234567890" }`,
+		errContains: ptr("untransformed bigint"),
+	},
+	{format: "smallcaps", label: "untransformed remotable",
+		body:        `["$0.Foo", "$0"]`,
+		slots:       []interface{}{"a"},
+		errContains: ptr("untransformed remotable"),
+	},
+	{format: "legacy", label: "untransformed remotable",
+		body:        `[{"@qclass":"slot","index":0,"iface":"Foo"}, {"@qclass":"slot","index":0}]`,
+		slots:       []interface{}{"a"},
+		errContains: ptr("untransformed remotable"),
+	},
+	{format: "smallcaps", label: "untransformed special number",
+		body:        `"#NaN"`,
+		errContains: ptr("untransformed special number"),
+	},
+	{format: "legacy", label: "untransformed undefined",
+		body:        `{"@qclass":"undefined"}`,
+		errContains: ptr("untransformed undefined"),
+	},
+	{format: "smallcaps", label: "untransformed symbol",
+		body:        `"%foo"`,
+		errContains: ptr("untransformed symbol"),
+	},
+	{format: "legacy", label: "untransformed tagged",
+		body:        `{"@qclass":"tagged","tag":"copySet","payload":[]}`,
+		errContains: ptr("untransformed tagged"),
+	},
+	{format: "smallcaps", label: "untransformed error",
+		body:        `{"#error":"foo","name":"Error"}`,
+		errContains: ptr("untransformed error"),
+	},
+
+	// invalid data
+	{format: "smallcaps", label: "iface mismatch",
+		body:        `["$0.Foo", "$0."]`,
+		slots:       []interface{}{"a"},
+		errContains: ptr("iface mismatch"),
+	},
+	{format: "legacy", label: "iface mismatch",
+		body:        `[{"@qclass":"slot","index":0,"iface":"Foo"}, {"@qclass":"slot","index":0,"iface":""}]`,
+		slots:       []interface{}{"a"},
+		errContains: ptr("iface mismatch"),
+	},
+	{format: "smallcaps", label: "invalid slot index (out of bounds)",
+		body:        `"$0.Foo"`,
+		errContains: ptr("invalid slot index"),
+	},
+	{format: "legacy", label: "invalid slot index (out of bounds)",
+		body:        `{"@qclass":"slot","index":0}`,
+		errContains: ptr("invalid slot index"),
+	},
+	{format: "smallcaps", label: "invalid slot index (bad format)",
+		body:        `"$x.Foo"`,
+		slots:       []interface{}{"a"},
+		errContains: ptr("invalid slot index"),
+	},
+	{format: "legacy", label: "invalid slot index (missing)",
+		body:        `{"@qclass":"slot"}`,
+		slots:       []interface{}{"a"},
+		errContains: ptr("invalid slot index"),
+	},
+	{format: "legacy", label: "invalid slot index (null)",
+		body:        `{"@qclass":"slot","index":null}`,
+		slots:       []interface{}{"a"},
+		errContains: ptr("invalid slot index"),
+	},
+	{format: "legacy", label: "invalid slot index (string)",
+		body:        `{"@qclass":"slot","index":"0"}`,
+		slots:       []interface{}{"a"},
+		errContains: ptr("invalid slot index"),
+	},
+	{format: "legacy", label: "invalid slot index (non-integer)",
+		body:        `{"@qclass":"slot","index":0.1}`,
+		slots:       []interface{}{"a"},
+		errContains: ptr("invalid slot index"),
+	},
+	{format: "legacy", label: "invalid slot index (negative)",
+		body:        `{"@qclass":"slot","index":-1}`,
+		slots:       []interface{}{"a"},
+		errContains: ptr("invalid slot index"),
+	},
+	{format: "legacy", label: "invalid slot iface (number)",
+		body:        `{"@qclass":"slot","index":0,"iface":0}`,
+		slots:       []interface{}{"a"},
+		errContains: ptr("invalid slot iface"),
+	},
+	{format: "smallcaps", label: "unrecognized record type",
+		body:        `{"#foo":0}`,
+		errContains: ptr("unrecognized record type"),
+	},
+	{format: "legacy", label: "invalid @qclass (null)",
+		body:        `{"@qclass":null}`,
+		errContains: ptr("invalid @qclass"),
+	},
+	{format: "legacy", label: "invalid @qclass (number)",
+		body:        `{"@qclass":1}`,
+		errContains: ptr("invalid @qclass"),
+	},
+	{format: "legacy", label: "invalid @qclass (number)",
+		body:        `{"@qclass":1}`,
+		errContains: ptr("invalid @qclass"),
+	},
+	{format: "legacy", label: "unrecognized @qclass",
+		body:        `{"@qclass":"foo"}`,
+		errContains: ptr("unrecognized @qclass"),
+	},
+	{format: "smallcaps", label: "invalid copyRecord key",
+		body:        `{"+0":0}`,
+		errContains: ptr("invalid copyRecord key"),
+	},
+	{format: "smallcaps", label: "invalid bigint (`--`)",
+		body:        `"--"`,
+		errContains: ptr("invalid bigint"),
+	},
+	{format: "smallcaps", label: "invalid bigint (`+0x`)",
+		body:        `"+0x"`,
+		errContains: ptr("invalid bigint"),
+	},
+	{format: "legacy", label: "invalid bigint (no digits)",
+		body:        `{"@qclass":"bigint"}`,
+		errContains: ptr("invalid bigint"),
+	},
+	{format: "legacy", label: "invalid bigint (null digits)",
+		body:        `{"@qclass":"bigint","digits":null}`,
+		errContains: ptr("invalid bigint"),
+	},
+	{format: "legacy", label: "invalid bigint (`7up`)",
+		body:        `{"@qclass":"bigint","digits":"7up"}`,
+		errContains: ptr("invalid bigint"),
+	},
+}
+
+func Test_DecodeSerializedCapdata(t *testing.T) {
+	testCases := decodingTestCases
 
 	for _, desc := range testCases {
 		slots := desc.slots
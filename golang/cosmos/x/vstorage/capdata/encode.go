@@ -0,0 +1,319 @@
+package capdata
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"strings"
+)
+
+// capdataEncoder accumulates the slots referenced by an encoded value.
+type capdataEncoder struct {
+	slots []interface{}
+	// slotIndices maps the JSON text of each slot to its index in slots.
+	slotIndices map[string]uint64
+}
+
+// encodeSlot returns the index of a Remotable's slot, adding it if it is new,
+// and whether or not it was new.
+func (enc *capdataEncoder) encodeSlot(r *CapdataRemotable) (uint64, bool, error) {
+	key, err := JsonMarshal(r.Id)
+	if err != nil {
+		return 0, false, err
+	}
+	if index, ok := enc.slotIndices[string(key)]; ok {
+		return index, false, nil
+	}
+	index := uint64(len(enc.slots))
+	enc.slots = append(enc.slots, r.Id)
+	enc.slotIndices[string(key)] = index
+	return index, true, nil
+}
+
+// sortedKeys returns the keys of a record in sorted order, so that slots are
+// numbered deterministically.
+func sortedKeys(obj map[string]interface{}) []string {
+	keys := make([]string, 0, len(obj))
+	for k := range obj {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// bigintDigits returns the normalized digits of a bigint value, if it is one.
+func bigintDigits(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case *CapdataBigint:
+		return v.Normalized, true
+	case *big.Int:
+		return v.String(), true
+	}
+	return "", false
+}
+
+// encodeNumber returns the JSON-compatible representation of a finite number,
+// if value is one.
+func encodeNumber(value interface{}) (interface{}, bool) {
+	switch v := value.(type) {
+	case float64:
+		if v == 0 {
+			// Normalize -0.
+			return float64(0), true
+		}
+		return v, true
+	case float32, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, json.Number:
+		return v, true
+	}
+	return nil, false
+}
+
+// specialNumberName returns the name of a non-finite number, if value is one.
+func specialNumberName(value interface{}) (string, bool) {
+	if special, ok := value.(*CapdataSpecialNumber); ok {
+		return special.Name, true
+	}
+	f, ok := value.(float64)
+	switch {
+	case !ok:
+		return "", false
+	case math.IsNaN(f):
+		return "NaN", true
+	case math.IsInf(f, 1):
+		return "Infinity", true
+	case math.IsInf(f, -1):
+		return "-Infinity", true
+	}
+	return "", false
+}
+
+// encodeSmallcapsValue encodes a value as in
+// https://github.com/endojs/endo/blob/master/packages/marshal/src/encodeToSmallcaps.js
+func (enc *capdataEncoder) encodeSmallcapsValue(value interface{}) (interface{}, error) {
+	if name, ok := specialNumberName(value); ok {
+		return "#" + name, nil
+	}
+	if digits, ok := bigintDigits(value); ok {
+		if !strings.HasPrefix(digits, "-") {
+			digits = "+" + digits
+		}
+		return digits, nil
+	}
+	if number, ok := encodeNumber(value); ok {
+		return number, nil
+	}
+	switch v := value.(type) {
+	case nil, bool:
+		return v, nil
+	case string:
+		// Escape strings that would otherwise be confused with special values.
+		if len(v) > 0 && v[0] >= '!' && v[0] <= '-' {
+			return "!" + v, nil
+		}
+		return v, nil
+	case []interface{}:
+		encoded := make([]interface{}, len(v))
+		for i, item := range v {
+			encodedItem, err := enc.encodeSmallcapsValue(item)
+			if err != nil {
+				return nil, err
+			}
+			encoded[i] = encodedItem
+		}
+		return encoded, nil
+	case map[string]interface{}:
+		encoded := make(map[string]interface{}, len(v))
+		for _, k := range sortedKeys(v) {
+			encodedK, err := enc.encodeSmallcapsValue(k)
+			if err != nil {
+				return nil, err
+			}
+			encodedV, err := enc.encodeSmallcapsValue(v[k])
+			if err != nil {
+				return nil, err
+			}
+			encoded[encodedK.(string)] = encodedV
+		}
+		return encoded, nil
+	case *CapdataRemotable:
+		index, isNew, err := enc.encodeSlot(v)
+		if err != nil {
+			return nil, err
+		}
+		if v.IsPromise {
+			return fmt.Sprintf("&%d", index), nil
+		}
+		if isNew && v.Iface != nil {
+			return fmt.Sprintf("$%d.%s", index, *v.Iface), nil
+		}
+		return fmt.Sprintf("$%d", index), nil
+	case *CapdataUndefined:
+		return "#undefined", nil
+	case *CapdataSymbol:
+		return "%" + v.Name, nil
+	case *CapdataError:
+		encoded := make(map[string]interface{}, len(v.Extra)+2)
+		for _, k := range sortedKeys(v.Extra) {
+			encodedV, err := enc.encodeSmallcapsValue(v.Extra[k])
+			if err != nil {
+				return nil, err
+			}
+			encoded[k] = encodedV
+		}
+		// The message and name are encoded as strings, escaping any special
+		// prefix character.
+		message, err := enc.encodeSmallcapsValue(v.Message)
+		if err != nil {
+			return nil, err
+		}
+		name, err := enc.encodeSmallcapsValue(v.Name)
+		if err != nil {
+			return nil, err
+		}
+		encoded["#error"] = message
+		encoded["name"] = name
+		return encoded, nil
+	case *CapdataTagged:
+		payload, err := enc.encodeSmallcapsValue(v.Payload)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"#tag": v.Tag, "payload": payload}, nil
+	}
+	return nil, fmt.Errorf("cannot encode %T", value)
+}
+
+// encodeLegacyValue encodes a value as in
+// https://github.com/endojs/endo/blob/master/packages/marshal/src/encodeToCapData.js
+func (enc *capdataEncoder) encodeLegacyValue(value interface{}) (interface{}, error) {
+	if name, ok := specialNumberName(value); ok {
+		return map[string]interface{}{"@qclass": name}, nil
+	}
+	if digits, ok := bigintDigits(value); ok {
+		return map[string]interface{}{"@qclass": "bigint", "digits": digits}, nil
+	}
+	if number, ok := encodeNumber(value); ok {
+		return number, nil
+	}
+	switch v := value.(type) {
+	case nil, bool, string:
+		return v, nil
+	case []interface{}:
+		encoded := make([]interface{}, len(v))
+		for i, item := range v {
+			encodedItem, err := enc.encodeLegacyValue(item)
+			if err != nil {
+				return nil, err
+			}
+			encoded[i] = encodedItem
+		}
+		return encoded, nil
+	case map[string]interface{}:
+		if qclassVal, ok := v["@qclass"]; ok {
+			// Move the conflicting property into a "Hilbert Hotel" record.
+			original, err := enc.encodeLegacyValue(qclassVal)
+			if err != nil {
+				return nil, err
+			}
+			hilbert := map[string]interface{}{"@qclass": "hilbert", "original": original}
+			if len(v) > 1 {
+				rest := make(map[string]interface{}, len(v)-1)
+				for k, item := range v {
+					if k != "@qclass" {
+						rest[k] = item
+					}
+				}
+				encodedRest, err := enc.encodeLegacyValue(rest)
+				if err != nil {
+					return nil, err
+				}
+				hilbert["rest"] = encodedRest
+			}
+			return hilbert, nil
+		}
+		encoded := make(map[string]interface{}, len(v))
+		for _, k := range sortedKeys(v) {
+			encodedV, err := enc.encodeLegacyValue(v[k])
+			if err != nil {
+				return nil, err
+			}
+			encoded[k] = encodedV
+		}
+		return encoded, nil
+	case *CapdataRemotable:
+		index, isNew, err := enc.encodeSlot(v)
+		if err != nil {
+			return nil, err
+		}
+		encoded := map[string]interface{}{"@qclass": "slot", "index": index}
+		if isNew && v.Iface != nil {
+			encoded["iface"] = *v.Iface
+		}
+		return encoded, nil
+	case *CapdataUndefined:
+		return map[string]interface{}{"@qclass": "undefined"}, nil
+	case *CapdataSymbol:
+		return map[string]interface{}{"@qclass": "symbol", "name": v.Name}, nil
+	case *CapdataError:
+		encoded := make(map[string]interface{}, len(v.Extra)+3)
+		for _, k := range sortedKeys(v.Extra) {
+			encodedV, err := enc.encodeLegacyValue(v.Extra[k])
+			if err != nil {
+				return nil, err
+			}
+			encoded[k] = encodedV
+		}
+		encoded["@qclass"] = "error"
+		encoded["message"] = v.Message
+		encoded["name"] = v.Name
+		return encoded, nil
+	case *CapdataTagged:
+		payload, err := enc.encodeLegacyValue(v.Payload)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"@qclass": "tagged", "tag": v.Tag, "payload": payload}, nil
+	}
+	return nil, fmt.Errorf("cannot encode %T", value)
+}
+
+// encodeCapdata encodes a value into CapData, prefixing its body.
+func encodeCapdata(
+	value interface{},
+	bodyPrefix string,
+	encodeValue func(enc *capdataEncoder, value interface{}) (interface{}, error),
+) (Capdata, error) {
+	enc := &capdataEncoder{slots: []interface{}{}, slotIndices: map[string]uint64{}}
+	encoded, err := encodeValue(enc, value)
+	if err != nil {
+		return Capdata{}, err
+	}
+	body, err := JsonMarshal(encoded)
+	if err != nil {
+		return Capdata{}, err
+	}
+	return Capdata{Body: bodyPrefix + string(body), Slots: enc.slots}, nil
+}
+
+// EncodeSmallcaps encodes a value into CapData with a "smallcaps" body, the
+// inverse of DecodeSerializedCapdata.
+// The value may consist of nil, booleans, strings, numbers (including NaN and
+// infinities, or *CapdataSpecialNumber), arrays ([]interface{}), records
+// (map[string]interface{}), bigints (*CapdataBigint or *big.Int), Remotables
+// (*CapdataRemotable, whose Id is used as its slot and whose Iface accompanies
+// its first reference), undefined (*CapdataUndefined), symbols
+// (*CapdataSymbol), tagged values (*CapdataTagged), and errors
+// (*CapdataError).
+// Slots are numbered in order of first reference, visiting record properties
+// in sorted order.
+func EncodeSmallcaps(value interface{}) (Capdata, error) {
+	return encodeCapdata(value, "#", (*capdataEncoder).encodeSmallcapsValue)
+}
+
+// EncodeLegacy encodes a value into CapData with a legacy "@qclass" body, as
+// described for EncodeSmallcaps.
+func EncodeLegacy(value interface{}) (Capdata, error) {
+	return encodeCapdata(value, "", (*capdataEncoder).encodeLegacyValue)
+}
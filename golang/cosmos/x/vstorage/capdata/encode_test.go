@@ -0,0 +1,176 @@
+package capdata
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func Test_EncodeCapdata(t *testing.T) {
+	type testCase struct {
+		label       string
+		value       interface{}
+		smallcaps   string
+		legacy      string
+		slots       []interface{}
+		errContains *string
+	}
+	foo, bar := "Alleged: Foo brand", "Bar"
+	testCases := []testCase{
+		{label: "JSON",
+			value:     map[string]interface{}{"a": []interface{}{1.5, true, nil, "x"}, "b": map[string]interface{}{}},
+			smallcaps: `{"a":[1.5,true,null,"x"],"b":{}}`,
+			legacy:    `{"a":[1.5,true,null,"x"],"b":{}}`,
+		},
+		{label: "escaped strings",
+			value:     []interface{}{"!", "#foo", "$0", "-1", ".", ""},
+			smallcaps: `["!!","!#foo","!$0","!-1",".",""]`,
+			legacy:    `["!","#foo","$0","-1",".",""]`,
+		},
+		{label: "escaped record keys",
+			value:     map[string]interface{}{"+0": 0.0, "a": 1},
+			smallcaps: `{"!+0":0,"a":1}`,
+			legacy:    `{"+0":0,"a":1}`,
+		},
+		{label: "bigints",
+			value:     []interface{}{NewCapdataBigint("0"), NewCapdataBigint("-42"), big.NewInt(7)},
+			smallcaps: `["+0","-42","+7"]`,
+			legacy:    `[{"@qclass":"bigint","digits":"0"},{"@qclass":"bigint","digits":"-42"},{"@qclass":"bigint","digits":"7"}]`,
+		},
+		{label: "special numbers",
+			value:     []interface{}{math.NaN(), math.Inf(1), math.Inf(-1), math.Copysign(0, -1)},
+			smallcaps: `["#NaN","#Infinity","#-Infinity",0]`,
+			legacy:    `[{"@qclass":"NaN"},{"@qclass":"Infinity"},{"@qclass":"-Infinity"},0]`,
+		},
+		{label: "remotables",
+			value: map[string]interface{}{
+				"a": &CapdataRemotable{Id: "board01", Iface: &foo},
+				"b": []interface{}{&CapdataRemotable{Id: "board02", Iface: &bar}, &CapdataRemotable{Id: "board01", Iface: &foo}},
+				"c": &CapdataRemotable{Id: "board03"},
+			},
+			smallcaps: `{"a":"$0.Alleged: Foo brand","b":["$1.Bar","$0"],"c":"$2"}`,
+			legacy:    `{"a":{"@qclass":"slot","iface":"Alleged: Foo brand","index":0},"b":[{"@qclass":"slot","iface":"Bar","index":1},{"@qclass":"slot","index":0}],"c":{"@qclass":"slot","index":2}}`,
+			slots:     []interface{}{"board01", "board02", "board03"},
+		},
+		{label: "tagged",
+			value:     &CapdataTagged{Tag: "copySet", Payload: []interface{}{"a", NewCapdataBigint("1")}},
+			smallcaps: `{"#tag":"copySet","payload":["a","+1"]}`,
+			legacy:    `{"@qclass":"tagged","payload":["a",{"@qclass":"bigint","digits":"1"}],"tag":"copySet"}`,
+		},
+		{label: "undefined and symbols",
+			value:     []interface{}{&CapdataUndefined{}, &CapdataSymbol{Name: "@@asyncIterator"}, &CapdataSpecialNumber{Name: "NaN"}},
+			smallcaps: `["#undefined","%@@asyncIterator","#NaN"]`,
+			legacy:    `[{"@qclass":"undefined"},{"@qclass":"symbol","name":"@@asyncIterator"},{"@qclass":"NaN"}]`,
+		},
+		{label: "errors",
+			value:     &CapdataError{Name: "TypeError", Message: "foo", Extra: map[string]interface{}{"errorId": "error:1"}},
+			smallcaps: `{"#error":"foo","errorId":"error:1","name":"TypeError"}`,
+			legacy:    `{"@qclass":"error","errorId":"error:1","message":"foo","name":"TypeError"}`,
+		},
+		{label: "errors with special prefixes",
+			value:     &CapdataError{Name: "#Error", Message: "-1 is not positive"},
+			smallcaps: `{"#error":"!-1 is not positive","name":"!#Error"}`,
+			legacy:    `{"@qclass":"error","message":"-1 is not positive","name":"#Error"}`,
+		},
+		{label: "promises",
+			value:     []interface{}{&CapdataRemotable{Id: "p1", IsPromise: true}, &CapdataRemotable{Id: "p1", IsPromise: true}},
+			smallcaps: `["&0","&0"]`,
+			legacy:    `[{"@qclass":"slot","index":0},{"@qclass":"slot","index":0}]`,
+			slots:     []interface{}{"p1"},
+		},
+		{label: "Hilbert Hotel",
+			value:     map[string]interface{}{"@qclass": "foo", "a": 1},
+			smallcaps: `{"@qclass":"foo","a":1}`,
+			legacy:    `{"@qclass":"hilbert","original":"foo","rest":{"a":1}}`,
+		},
+		{label: "unsupported",
+			value:       struct{}{},
+			errContains: ptr("cannot encode"),
+		},
+	}
+	for _, desc := range testCases {
+		slots := desc.slots
+		if slots == nil {
+			slots = []interface{}{}
+		}
+		for _, format := range []string{"smallcaps", "legacy"} {
+			label := fmt.Sprintf("%s %s", format, desc.label)
+			encode, expected := EncodeSmallcaps, Capdata{"#" + desc.smallcaps, slots}
+			if format == "legacy" {
+				encode, expected = EncodeLegacy, Capdata{desc.legacy, slots}
+			}
+			got, err := encode(desc.value)
+			if desc.errContains == nil {
+				if err != nil {
+					t.Errorf("%s: got unexpected error %v", label, err)
+				} else if !reflect.DeepEqual(got, expected) {
+					t.Errorf("%s: got %s, want %s", label, mustJsonMarshal(got), mustJsonMarshal(expected))
+				}
+			} else if err == nil {
+				t.Errorf("%s: got no error, want error %q", label, *desc.errContains)
+			} else if !strings.Contains(err.Error(), *desc.errContains) {
+				t.Errorf("%s: got error %v, want error %q", label, err, *desc.errContains)
+			}
+		}
+	}
+}
+
+// Test_EncodeCapdata_RoundTrip verifies that encoding the result of decoding
+// each successful decoding test case reproduces its input.
+func Test_EncodeCapdata_RoundTrip(t *testing.T) {
+	transformations := CapdataValueTransformations{
+		Bigint:        func(bigint *CapdataBigint) interface{} { return bigint },
+		Remotable:     func(r *CapdataRemotable) interface{} { return r.Id },
+		SpecialNumber: func(n *CapdataSpecialNumber) interface{} { return n },
+		Undefined:     func(u *CapdataUndefined) interface{} { return u },
+		Symbol:        func(s *CapdataSymbol) interface{} { return s },
+		Tagged:        func(t *CapdataTagged) interface{} { return t },
+		Error:         func(e *CapdataError) interface{} { return e },
+	}
+	for _, desc := range decodingTestCases {
+		if desc.errContains != nil {
+			continue
+		}
+		slots := desc.slots
+		if slots == nil {
+			slots = []interface{}{}
+		}
+		for _, format := range []string{"smallcaps", "legacy"} {
+			if desc.format != "" && desc.format != format {
+				continue
+			}
+			label := fmt.Sprintf("%s %s", format, desc.label)
+			if desc.label == "" {
+				label = fmt.Sprintf("%s %s", format, desc.body)
+			}
+			original := Capdata{desc.body, slots}
+			encode := EncodeLegacy
+			if format == "smallcaps" {
+				original.Body = "#" + original.Body
+				encode = EncodeSmallcaps
+			}
+			decoded, err := DecodeSerializedCapdata(mustJsonMarshal(original), transformations)
+			if err != nil {
+				t.Errorf("%s: got unexpected decoding error %v", label, err)
+				continue
+			}
+			got, err := encode(decoded)
+			if err != nil {
+				t.Errorf("%s: got unexpected encoding error %v", label, err)
+				continue
+			}
+
+			// Compare bodies as JSON values to ignore insignificant differences.
+			var gotBody, expectedBody interface{}
+			mustJsonUnmarshal(strings.TrimPrefix(got.Body, "#"), &gotBody)
+			mustJsonUnmarshal(desc.body, &expectedBody)
+			if strings.HasPrefix(got.Body, "#") != (format == "smallcaps") ||
+				!reflect.DeepEqual(gotBody, expectedBody) || !reflect.DeepEqual(got.Slots, slots) {
+				t.Errorf("%s: got %s, want %s", label, mustJsonMarshal(got), mustJsonMarshal(original))
+			}
+		}
+	}
+}
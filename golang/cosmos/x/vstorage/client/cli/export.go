@@ -0,0 +1,195 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/types"
+)
+
+const FlagPageLimit = "page-limit"
+
+var _ agoric.KVEntryReader = &entriesWalkReader{}
+
+// entriesWalkReader is a KVEntryReader that yields an entry for each
+// descendant with data of a vstorage path, keyed by its full path.
+// It walks the tree depth-first, reading one page of children at a time with
+// the Entries query, so the whole subtree is never held in memory, and only
+// querying the children of nodes that Entries reports as having any.
+// Every query after the first is pinned to the block height reported by the
+// first, so that the entries are a consistent snapshot.
+type entriesWalkReader struct {
+	ctx         context.Context
+	queryClient types.QueryClient
+	pageLimit   uint64
+	// height is the block height of the walk, or 0 until the first response.
+	height int64
+	// pending holds the paths whose children have not yet been fully read,
+	// with the next one to read at the end.
+	pending []*pendingChildren
+	// buffered holds entries read but not yet yielded.
+	buffered []agoric.KVEntry
+}
+
+type pendingChildren struct {
+	path    string
+	nextKey []byte
+}
+
+// NewEntriesWalkReader returns a KVEntryReader over the descendants with data
+// of pathPrefix (but not pathPrefix itself), as reported by queryClient.
+func NewEntriesWalkReader(ctx context.Context, queryClient types.QueryClient, pathPrefix string, pageLimit uint64) agoric.KVEntryReader {
+	return &entriesWalkReader{
+		ctx:         ctx,
+		queryClient: queryClient,
+		pageLimit:   pageLimit,
+		pending:     []*pendingChildren{{path: pathPrefix}},
+	}
+}
+
+// Read yields the next descendant entry.
+// Implements KVEntryReader
+func (wr *entriesWalkReader) Read() (agoric.KVEntry, error) {
+	for len(wr.buffered) == 0 {
+		if len(wr.pending) == 0 {
+			return agoric.KVEntry{}, io.EOF
+		}
+		if err := wr.readPage(); err != nil {
+			return agoric.KVEntry{}, err
+		}
+	}
+	next := wr.buffered[0]
+	wr.buffered = wr.buffered[1:]
+	return next, nil
+}
+
+// readPage reads the next page of children of the most recently discovered
+// pending path, buffering those with data and scheduling those with children
+// to have them read before any later page of their siblings.
+func (wr *entriesWalkReader) readPage() error {
+	parent := wr.pending[len(wr.pending)-1]
+	var header metadata.MD
+	res, err := wr.queryClient.Entries(wr.ctx, &types.QueryEntriesRequest{
+		Path:       parent.path,
+		Pagination: &query.PageRequest{Key: parent.nextKey, Limit: wr.pageLimit},
+	}, grpc.Header(&header))
+	if err != nil {
+		return err
+	}
+	if wr.height == 0 {
+		if err := wr.pinHeight(header); err != nil {
+			return err
+		}
+	}
+
+	if res.Pagination != nil && len(res.Pagination.NextKey) > 0 {
+		parent.nextKey = res.Pagination.NextKey
+	} else {
+		wr.pending = wr.pending[:len(wr.pending)-1]
+	}
+
+	prefix := ""
+	if parent.path != "" {
+		prefix = parent.path + types.PathSeparator
+	}
+	for i := len(res.Entries) - 1; i >= 0; i-- {
+		if res.Entries[i].HasChildren {
+			wr.pending = append(wr.pending, &pendingChildren{path: prefix + res.Entries[i].Child})
+		}
+	}
+	for _, entry := range res.Entries {
+		if entry.HasValue {
+			wr.buffered = append(wr.buffered, agoric.NewKVEntry(prefix+entry.Child, entry.Value))
+		}
+	}
+	return nil
+}
+
+// pinHeight makes all subsequent queries use the block height reported in the
+// header of the first response.
+func (wr *entriesWalkReader) pinHeight(header metadata.MD) error {
+	heights := header.Get(grpctypes.GRPCBlockHeightHeader)
+	if len(heights) == 0 {
+		return fmt.Errorf("Entries response has no block height")
+	}
+	height, err := strconv.ParseInt(heights[0], 10, 64)
+	if err != nil || height <= 0 {
+		return fmt.Errorf("Entries response has invalid block height %q", heights[0])
+	}
+	wr.height = height
+	wr.ctx = metadata.AppendToOutgoingContext(wr.ctx, grpctypes.GRPCBlockHeightHeader, heights[0])
+	return nil
+}
+
+// Height returns the block height of the entries read so far, or 0 if none
+// have been queried yet.
+func (wr *entriesWalkReader) Height() int64 {
+	return wr.height
+}
+
+// Close abandons the walk.
+// Implements KVEntryReader
+func (wr *entriesWalkReader) Close() error {
+	wr.pending = nil
+	wr.buffered = nil
+	return nil
+}
+
+// GetCmdExport streams vstorage data under a path as JSON Lines
+func GetCmdExport(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export [path]",
+		Short: "export data under vstorage path as JSON Lines",
+		Long: `export data under vstorage path as JSON Lines.
+When absent, path defaults to the empty root path.
+Each descendant of path that has data is written as a line containing a
+[fullPath, value] JSON array, in the format accepted by add-genesis-vstorage.
+Data at path itself is not included.
+All data is read at a single block height, the latest unless --height is
+specified.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			path := ""
+			if len(args) > 0 {
+				path = args[0]
+			}
+			if err := types.ValidatePath(path); err != nil {
+				return err
+			}
+
+			pageLimit, err := cmd.Flags().GetUint64(FlagPageLimit)
+			if err != nil {
+				return err
+			}
+
+			reader := NewEntriesWalkReader(cmd.Context(), queryClient, path, pageLimit)
+			defer reader.Close()
+			if err := agoric.EncodeKVEntryReaderToJsonl(reader, cmd.OutOrStdout()); err != nil {
+				return err
+			}
+			cmd.PrintErrf("exported vstorage data at height %d\n", reader.(*entriesWalkReader).Height())
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagPageLimit, 100, "maximum number of children to request per query")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,117 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/keeper"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/types"
+)
+
+// querierClient adapts a Querier into the subset of types.QueryClient used
+// by entriesWalkReader, reporting the block height of its context like
+// client.Context does.
+type querierClient struct {
+	types.QueryClient
+	querier keeper.Querier
+	ctx     sdk.Context
+	calls   int
+	// requestedHeights are the block heights requested by each query, if any.
+	requestedHeights []string
+}
+
+func (qc *querierClient) Entries(ctx context.Context, req *types.QueryEntriesRequest, opts ...grpc.CallOption) (*types.QueryEntriesResponse, error) {
+	qc.calls++
+	md, _ := metadata.FromOutgoingContext(ctx)
+	qc.requestedHeights = append(qc.requestedHeights, strings.Join(md.Get(grpctypes.GRPCBlockHeightHeader), ","))
+	for _, opt := range opts {
+		if header, ok := opt.(grpc.HeaderCallOption); ok {
+			*header.HeaderAddr = metadata.Pairs(grpctypes.GRPCBlockHeightHeader, fmt.Sprint(qc.ctx.BlockHeight()))
+		}
+	}
+	return qc.querier.Entries(sdk.WrapSDKContext(qc.ctx), req)
+}
+
+func TestEntriesWalkReader(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	metaStoreKey := storetypes.NewKVStoreKey(types.MetaStoreKey)
+	paramsStoreKey := storetypes.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTStoreKey := storetypes.NewTransientStoreKey(paramstypes.TStoreKey)
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(metaStoreKey, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsStoreKey, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsTStoreKey, storetypes.StoreTypeTransient, db)
+	if err := ms.LoadLatestVersion(); err != nil {
+		t.Fatal(err)
+	}
+	ctx := sdk.NewContext(ms, tmproto.Header{Height: 7}, false, log.NewNopLogger())
+	pk := paramskeeper.NewKeeper(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), codec.NewLegacyAmino(), paramsStoreKey, paramsTStoreKey)
+	k := keeper.NewKeeper(storeKey, metaStoreKey, pk.Subspace(types.ModuleName))
+
+	for _, path := range []string{
+		"published.a", "published.a.x", "published.b.y.z", "published.c",
+		"published.d", "published.e", "unpublished.f",
+	} {
+		k.SetStorage(ctx, agoric.NewKVEntry(path, "v:"+path))
+	}
+	k.SetStorage(ctx, agoric.NewKVEntry("published", "v:published"))
+
+	client := &querierClient{querier: keeper.Querier{Keeper: k}, ctx: ctx}
+	reader := NewEntriesWalkReader(context.Background(), client, "published", 2)
+	defer reader.Close()
+
+	var out bytes.Buffer
+	if err := agoric.EncodeKVEntryReaderToJsonl(reader, &out); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := strings.Join([]string{
+		`["published.a","v:published.a"]`,
+		`["published.a.x","v:published.a.x"]`,
+		`["published.b.y.z","v:published.b.y.z"]`,
+		`["published.c","v:published.c"]`,
+		`["published.d","v:published.d"]`,
+		`["published.e","v:published.e"]`,
+		``,
+	}, "\n")
+	if out.String() != expected {
+		t.Errorf("got %s, want %s", out.String(), expected)
+	}
+	// One query per page of each node with children, skipping leaves:
+	// published (3 pages), a, b, b.y.
+	if client.calls != 6 {
+		t.Errorf("got %d Entries queries, want 6", client.calls)
+	}
+	// Only the first query is at the latest height, the rest at its height.
+	for i, height := range client.requestedHeights {
+		expectedHeight := "7"
+		if i == 0 {
+			expectedHeight = ""
+		}
+		if height != expectedHeight {
+			t.Errorf("got query %d at height %q, want %q", i, height, expectedHeight)
+		}
+	}
+	if height := reader.(*entriesWalkReader).Height(); height != 7 {
+		t.Errorf("got reader height %d, want 7", height)
+	}
+}
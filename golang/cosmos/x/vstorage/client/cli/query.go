@@ -19,6 +19,13 @@ func GetQueryCmd(storeKey string) *cobra.Command {
 	swingsetQueryCmd.AddCommand(
 		GetCmdGetData(storeKey),
 		GetCmdGetChildren(storeKey),
+		GetCmdGetEntries(storeKey),
+		GetCmdGetValues(storeKey),
+		GetCmdGetSize(storeKey),
+		GetCmdGetStreamCellHistory(storeKey),
+		GetCmdGetParams(storeKey),
+		GetCmdGetUsage(storeKey),
+		GetCmdExport(storeKey),
 		GetCmdGetPath(storeKey),
 	)
 
@@ -93,6 +100,242 @@ Path segments are dot-separated, so a child "baz" under path "foo.bar" has path
 	return cmd
 }
 
+// GetCmdGetEntries queries vstorage children along with their data
+func GetCmdGetEntries(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "entries [path]",
+		Short: "get child path segments and their data under vstorage path",
+		Long: `get child path segments and their data under vstorage path.
+When absent, path defaults to the empty root path.
+Each entry has a hasValue field distinguishing a child with data from one that
+exists only to provide linkage to its own children.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			path := ""
+			if len(args) > 0 {
+				path = args[0]
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Entries(cmd.Context(), &types.QueryEntriesRequest{
+				Path:       path,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "entries")
+	return cmd
+}
+
+// GetCmdGetValues queries the data of vstorage children
+func GetCmdGetValues(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "values [path]",
+		Short: "get data for each child under vstorage path",
+		Long: `get data for each child under vstorage path, in the same order as children.
+When absent, path defaults to the empty root path.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			path := ""
+			if len(args) > 0 {
+				path = args[0]
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Values(cmd.Context(), &types.QueryValuesRequest{
+				Path:       path,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "values")
+	return cmd
+}
+
+// GetCmdGetSize queries the count of vstorage children
+func GetCmdGetSize(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "size [path]",
+		Short: "get the count of children under vstorage path",
+		Long: `get the count of children under vstorage path.
+When absent, path defaults to the empty root path.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			path := ""
+			if len(args) > 0 {
+				path = args[0]
+			}
+
+			res, err := queryClient.Size(cmd.Context(), &types.QuerySizeRequest{
+				Path: path,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdGetParams queries the vstorage module parameters
+func GetCmdGetParams(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "get the vstorage module parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdGetUsage queries the storage used under each top-level path
+func GetCmdGetUsage(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "usage",
+		Short: "get the bytes used under each top-level vstorage path",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Usage(cmd.Context(), &types.QueryUsageRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "usage")
+	return cmd
+}
+
+const (
+	FlagMinHeight = "min-height"
+	FlagMaxHeight = "max-height"
+)
+
+// GetCmdGetStreamCellHistory queries the StreamCells retained for a vstorage path
+func GetCmdGetStreamCellHistory(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history <path>",
+		Short: "get StreamCells written at vstorage path in past blocks",
+		Long: `get StreamCells written at vstorage path in past blocks.
+Only blocks executed by the queried node since it began retaining history for
+the path (cf. its --vstorage-stream-cell-history-paths option) are available.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			minHeight, err := cmd.Flags().GetInt64(FlagMinHeight)
+			if err != nil {
+				return err
+			}
+			maxHeight, err := cmd.Flags().GetInt64(FlagMaxHeight)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.StreamCellHistory(cmd.Context(), &types.QueryStreamCellHistoryRequest{
+				Path:       args[0],
+				MinHeight:  minHeight,
+				MaxHeight:  maxHeight,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Int64(FlagMinHeight, 0, "lowest block height to include")
+	cmd.Flags().Int64(FlagMaxHeight, 0, "highest block height to include (0 for no limit)")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "history")
+	return cmd
+}
+
 // GetCmdGetPath queries vstorage data or children, depending on the path
 func GetCmdGetPath(queryRoute string) *cobra.Command {
 	cmd := &cobra.Command{
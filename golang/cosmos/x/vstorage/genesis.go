@@ -2,7 +2,9 @@ package vstorage
 
 import (
 	"fmt"
+	"io"
 
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -18,6 +20,9 @@ func ValidateGenesis(data *types.GenesisState) error {
 	if data == nil {
 		return nil
 	}
+	if err := data.Params.ValidateBasic(); err != nil {
+		return err
+	}
 	for _, entry := range data.Data {
 		if err := types.ValidatePath(entry.Path); err != nil {
 			return fmt.Errorf("genesis vstorage.data entry %q has invalid path format: %s", entry.Path, err)
@@ -28,11 +33,13 @@ func ValidateGenesis(data *types.GenesisState) error {
 
 func DefaultGenesisState() *types.GenesisState {
 	return &types.GenesisState{
-		Data: []*types.DataEntry{},
+		Data:   []*types.DataEntry{},
+		Params: types.DefaultParams(),
 	}
 }
 
 func InitGenesis(ctx sdk.Context, keeper Keeper, data *types.GenesisState) []abci.ValidatorUpdate {
+	keeper.SetParams(ctx, data.Params)
 	keeper.ImportStorage(ctx, data.Data)
 	return []abci.ValidatorUpdate{}
 }
@@ -40,5 +47,51 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data *types.GenesisState) []abc
 func ExportGenesis(ctx sdk.Context, keeper Keeper) *types.GenesisState {
 	gs := NewGenesisState()
 	gs.Data = keeper.ExportStorage(ctx)
+	gs.Params = keeper.GetParams(ctx)
 	return gs
 }
+
+// MergeGenesisEntries updates data with the entries yielded by reader, in the
+// same manner as repeated SetStorage calls: an entry with a value replaces
+// any existing entry at the same path (or is appended if there is none), and
+// an entry with no value removes any existing entry at the same path.
+// It does not Close the reader.
+func MergeGenesisEntries(data *types.GenesisState, reader agoric.KVEntryReader) error {
+	indexByPath := make(map[string]int, len(data.Data))
+	for i, entry := range data.Data {
+		indexByPath[entry.Path] = i
+	}
+	for {
+		entry, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+		path := entry.Key()
+		if err := types.ValidatePath(path); err != nil {
+			return err
+		}
+		i, exists := indexByPath[path]
+		switch {
+		case entry.HasValue() && exists:
+			data.Data[i] = &types.DataEntry{Path: path, Value: entry.StringValue()}
+		case entry.HasValue():
+			indexByPath[path] = len(data.Data)
+			data.Data = append(data.Data, &types.DataEntry{Path: path, Value: entry.StringValue()})
+		case exists:
+			// Mark for removal, preserving the indices of later entries.
+			data.Data[i] = nil
+			delete(indexByPath, path)
+		}
+	}
+
+	merged := make([]*types.DataEntry, 0, len(indexByPath))
+	for _, entry := range data.Data {
+		if entry != nil {
+			merged = append(merged, entry)
+		}
+	}
+	data.Data = merged
+	return nil
+}
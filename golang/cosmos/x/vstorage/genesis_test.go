@@ -0,0 +1,46 @@
+package vstorage
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/types"
+)
+
+func TestMergeGenesisEntries(t *testing.T) {
+	data := &types.GenesisState{
+		Data: []*types.DataEntry{
+			{Path: "a", Value: "old a"},
+			{Path: "b.c", Value: "old b.c"},
+			{Path: "d", Value: "old d"},
+		},
+	}
+	lines := []json.RawMessage{
+		json.RawMessage(`["b.c"]`),
+		json.RawMessage(`["e.f","new e.f"]`),
+		json.RawMessage(`["a","new a"]`),
+		json.RawMessage(`["absent"]`),
+	}
+	reader := agoric.NewJsonRawMessageKVEntriesReader(lines)
+	defer reader.Close()
+
+	if err := MergeGenesisEntries(data, reader); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []*types.DataEntry{
+		{Path: "a", Value: "new a"},
+		{Path: "d", Value: "old d"},
+		{Path: "e.f", Value: "new e.f"},
+	}
+	if !reflect.DeepEqual(data.Data, expected) {
+		t.Errorf("got %v, want %v", data.Data, expected)
+	}
+
+	badReader := agoric.NewJsonRawMessageKVEntriesReader([]json.RawMessage{json.RawMessage(`["bad..path","x"]`)})
+	defer badReader.Close()
+	if err := MergeGenesisEntries(data, badReader); err == nil {
+		t.Errorf("got no error for invalid path")
+	}
+}
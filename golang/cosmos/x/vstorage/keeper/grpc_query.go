@@ -1,14 +1,18 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/capdata"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -46,29 +50,44 @@ func (k Querier) Data(c context.Context, req *types.QueryDataRequest) (*types.Qu
 const (
 	// Media types.
 	JSONLines = "JSON Lines"
+	CSV       = "text/csv"
+	TSV       = "text/tab-separated-values"
 
 	// CapData transformation formats.
-	FormatCapDataFlat = "flat"
+	FormatCapDataFlat          = "flat"
+	FormatCapDataSmallcapsJSON = "smallcaps-json"
 
 	// CapData remotable value formats.
 	FormatRemotableAsObject = "object"
 	FormatRemotableAsString = "string"
+	FormatRemotableAsSlot   = "slot"
 )
 
 var capDataResponseMediaTypes = map[string]string{
 	JSONLines: JSONLines,
+	CSV:       CSV,
+	TSV:       TSV,
 	// Default to JSON Lines.
 	"": JSONLines,
 }
 var capDataTransformationFormats = map[string]string{
-	FormatCapDataFlat: FormatCapDataFlat,
+	FormatCapDataFlat:          FormatCapDataFlat,
+	FormatCapDataSmallcapsJSON: FormatCapDataSmallcapsJSON,
 	// Default to no transformation.
 	"": "",
 }
 var capDataRemotableValueFormats = map[string]string{
 	FormatRemotableAsObject: FormatRemotableAsObject,
 	FormatRemotableAsString: FormatRemotableAsString,
-	// No default because both formats are lossy.
+	FormatRemotableAsSlot:   FormatRemotableAsSlot,
+	// No default because the choice of format is significant.
+}
+
+// capDataDelimiters maps each delimiter-separated values media type to its
+// field delimiter.
+var capDataDelimiters = map[string]rune{
+	CSV: ',',
+	TSV: '\t',
 }
 
 // flatten converts data into a flat structure in which each deep leaf entry is replaced with
@@ -96,16 +115,9 @@ var capDataRemotableValueFormats = map[string]string{
 // ```
 func flatten(input interface{}, output map[string]interface{}, key string, top bool) error {
 	// Act on the raw representation of a Remotable.
-	if remotable, ok := input.(*capdata.CapdataRemotable); ok {
-		var replacement interface{}
-		repr, err := capdata.JsonMarshal(remotable)
-		if err == nil {
-			err = json.Unmarshal(repr, &replacement)
-		}
-		if err != nil {
-			return err
-		}
-		input = replacement
+	input, err := unwrapRemotable(input)
+	if err != nil {
+		return err
 	}
 
 	childKeyPrefix := key
@@ -140,14 +152,52 @@ func capdataBigintToDigits(bigint *capdata.CapdataBigint) interface{} {
 	return bigint.Normalized
 }
 
+// capdataSpecialNumberToName represents a non-finite number as its name
+// (e.g., "NaN" or "-Infinity").
+func capdataSpecialNumberToName(special *capdata.CapdataSpecialNumber) interface{} {
+	return special.Name
+}
+
+// capdataUndefinedToNull represents undefined as null.
+func capdataUndefinedToNull(*capdata.CapdataUndefined) interface{} {
+	return nil
+}
+
+// capdataSymbolToString represents a symbol as a string in the style of
+// JavaScript `String(symbol)` (e.g., "Symbol(@@asyncIterator)").
+func capdataSymbolToString(symbol *capdata.CapdataSymbol) interface{} {
+	return fmt.Sprintf("Symbol(%s)", symbol.Name)
+}
+
+// capdataTaggedToObject represents a tagged value as an object containing its
+// tag and payload (e.g., `{ "tag": "copySet", "payload": [] }`).
+func capdataTaggedToObject(tagged *capdata.CapdataTagged) interface{} {
+	return map[string]interface{}{"tag": tagged.Tag, "payload": tagged.Payload}
+}
+
+// capdataErrorToObject represents an error as an object containing its name
+// and message (e.g., `{ "name": "TypeError", "message": "oops" }`).
+func capdataErrorToObject(e *capdata.CapdataError) interface{} {
+	return map[string]interface{}{"name": e.Name, "message": e.Message}
+}
+
+// capdataRemotableIface returns the iface of a Remotable, defaulting to
+// "Promise" or "Remotable" when it has none.
+func capdataRemotableIface(r *capdata.CapdataRemotable) string {
+	if r.Iface != nil && *r.Iface != "" {
+		return *r.Iface
+	}
+	if r.IsPromise {
+		return "Promise"
+	}
+	return "Remotable"
+}
+
 // capdataRemotableToString represents a Remotable as a bracketed string
 // containing its alleged name and id from `slots`
 // (e.g., "[Alleged: IST brand <board007>]").
 func capdataRemotableToString(r *capdata.CapdataRemotable) interface{} {
-	iface := "Remotable"
-	if r.Iface != nil || *r.Iface != "" {
-		iface = *r.Iface
-	}
+	iface := capdataRemotableIface(r)
 	return fmt.Sprintf("[%s <%s>]", iface, r.Id)
 }
 
@@ -155,12 +205,117 @@ func capdataRemotableToString(r *capdata.CapdataRemotable) interface{} {
 // its id from `slots` and its alleged name minus any "Alleged:" prefix
 // (e.g., `{ "id": "board007", "allegedName": "IST brand" }`).
 func capdataRemotableToObject(r *capdata.CapdataRemotable) interface{} {
-	iface := "Remotable"
-	if r.Iface != nil || *r.Iface != "" {
+	iface, _ := strings.CutPrefix(capdataRemotableIface(r), "Alleged: ")
+	return map[string]interface{}{"id": r.Id, "allegedName": iface}
+}
+
+// capdataRemotableToSlot represents a Remotable as an object containing
+// its slot from `slots` and its full iface or null
+// (e.g., `{ "slot": "board007", "iface": "Alleged: IST brand" }`).
+func capdataRemotableToSlot(r *capdata.CapdataRemotable) interface{} {
+	var iface interface{}
+	if r.Iface != nil {
 		iface = *r.Iface
-		iface, _ = strings.CutPrefix(iface, "Alleged: ")
 	}
-	return map[string]interface{}{"id": r.Id, "allegedName": iface}
+	return map[string]interface{}{"slot": r.Id, "iface": iface}
+}
+
+// smallcapsPassthrough returns the CapData serialized as capDataJson
+// unchanged except for normalized JSON formatting, after verifying that it
+// uses smallcaps encoding.
+func smallcapsPassthrough(capDataJson string) (*capdata.Capdata, error) {
+	var item capdata.Capdata
+	if err := json.Unmarshal([]byte(capDataJson), &item); err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(item.Body, "#") {
+		return nil, fmt.Errorf("invalid CapData: body is not smallcaps-encoded")
+	}
+	if item.Slots == nil {
+		return nil, fmt.Errorf("invalid CapData: missing slots")
+	}
+	return &item, nil
+}
+
+// tsvFieldEscaper escapes the characters that cannot appear in a
+// text/tab-separated-values field, following the common backslash convention.
+var tsvFieldEscaper = strings.NewReplacer(`\`, `\\`, "\t", `\t`, "\n", `\n`, "\r", `\r`)
+
+// formatDelimitedValues renders flattened items as delimiter-separated
+// values, with a header row consisting of the sorted union of their keys
+// followed by a row for each item.
+// A scalar item is a record whose only key is "$" (as in a JSONPath
+// expression for the root value).
+// A string value is rendered as itself, a missing or null value as an empty
+// field, and any other value as JSON text.
+// CSV fields are quoted as necessary per RFC 4180, and TSV fields have any
+// backslash, tab, carriage return, or line feed escaped as `\\`, `\t`, `\r`,
+// or `\n`.
+func formatDelimitedValues(items []interface{}, delimiter rune) (string, error) {
+	records := make([]map[string]interface{}, len(items))
+	keySet := map[string]bool{}
+	for i, item := range items {
+		record, ok := item.(map[string]interface{})
+		if !ok {
+			// A scalar item remains unflattened.
+			record = map[string]interface{}{"$": item}
+		}
+		for key := range record {
+			keySet[key] = true
+		}
+		records[i] = record
+	}
+	keys := make([]string, 0, len(keySet))
+	for key := range keySet {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	buf := &bytes.Buffer{}
+	writeRow := func(row []string) error {
+		for i, field := range row {
+			row[i] = tsvFieldEscaper.Replace(field)
+		}
+		buf.WriteString(strings.Join(row, "\t"))
+		buf.WriteString("\n")
+		return nil
+	}
+	var csvWriter *csv.Writer
+	if delimiter != '\t' {
+		csvWriter = csv.NewWriter(buf)
+		csvWriter.Comma = delimiter
+		writeRow = csvWriter.Write
+	}
+
+	if err := writeRow(keys); err != nil {
+		return "", err
+	}
+	for _, record := range records {
+		row := make([]string, len(keys))
+		for i, key := range keys {
+			switch value := record[key].(type) {
+			case nil:
+			case string:
+				row[i] = value
+			default:
+				jsonText, err := capdata.JsonMarshal(value)
+				if err != nil {
+					return "", err
+				}
+				row[i] = string(jsonText)
+			}
+		}
+		if err := writeRow(row); err != nil {
+			return "", err
+		}
+	}
+	if csvWriter != nil {
+		csvWriter.Flush()
+		if err := csvWriter.Error(); err != nil {
+			return "", err
+		}
+	}
+	return buf.String(), nil
 }
 
 // /agoric.vstorage.Query/CapData returns data for a specified path,
@@ -173,12 +328,14 @@ func (k Querier) CapData(c context.Context, req *types.QueryCapDataRequest) (*ty
 	ctx := sdk.UnwrapSDKContext(c)
 
 	valueTransformations := capdata.CapdataValueTransformations{
-		Bigint: capdataBigintToDigits,
+		Bigint:        capdataBigintToDigits,
+		SpecialNumber: capdataSpecialNumberToName,
+		Undefined:     capdataUndefinedToNull,
+		Symbol:        capdataSymbolToString,
+		Tagged:        capdataTaggedToObject,
+		Error:         capdataErrorToObject,
 	}
 
-	// A response Value is "<prefix><separator-joined items><suffix>".
-	prefix, separator, suffix := "", "\n", ""
-
 	// Read options.
 	mediaType, ok := capDataResponseMediaTypes[req.MediaType]
 	if !ok {
@@ -188,13 +345,34 @@ func (k Querier) CapData(c context.Context, req *types.QueryCapDataRequest) (*ty
 	if !ok {
 		return nil, status.Error(codes.InvalidArgument, "invalid item_format")
 	}
+	_, delimited := capDataDelimiters[mediaType]
+	if delimited && transformation != FormatCapDataFlat {
+		return nil, status.Error(codes.InvalidArgument, "media_type requires item_format \"flat\"")
+	}
+	var selector *capDataSelector
+	if req.Selector != "" {
+		if transformation == FormatCapDataSmallcapsJSON {
+			return nil, status.Error(codes.InvalidArgument, "selector cannot be used with item_format \"smallcaps-json\"")
+		}
+		var err error
+		selector, err = parseCapDataSelector(req.Selector)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid selector: "+err.Error())
+		}
+	}
 	switch remotableFormat, ok := capDataRemotableValueFormats[req.RemotableValueFormat]; {
+	case transformation == FormatCapDataSmallcapsJSON && req.RemotableValueFormat == "":
+		// Remotables are passed through.
+	case transformation == FormatCapDataSmallcapsJSON:
+		return nil, status.Error(codes.InvalidArgument, "remotable_value_format cannot be used with item_format \"smallcaps-json\"")
 	case !ok:
 		return nil, status.Error(codes.InvalidArgument, "invalid remotable_value_format")
 	case remotableFormat == FormatRemotableAsObject:
 		valueTransformations.Remotable = capdataRemotableToObject
 	case remotableFormat == FormatRemotableAsString:
 		valueTransformations.Remotable = capdataRemotableToString
+	case remotableFormat == FormatRemotableAsSlot:
+		valueTransformations.Remotable = capdataRemotableToSlot
 	}
 
 	// Read data, auto-upgrading a standalone value to a single-value StreamCell.
@@ -209,13 +387,27 @@ func (k Querier) CapData(c context.Context, req *types.QueryCapDataRequest) (*ty
 		cell = StreamCell{Values: []string{value}}
 	}
 
-	// Format each StreamCell value.
-	responseItems := make([]string, len(cell.Values))
+	// Transform each StreamCell value.
+	items := make([]interface{}, len(cell.Values))
 	for i, capDataJson := range cell.Values {
+		if transformation == FormatCapDataSmallcapsJSON {
+			item, err := smallcapsPassthrough(capDataJson)
+			if err != nil {
+				return nil, status.Error(codes.FailedPrecondition, err.Error())
+			}
+			items[i] = item
+			continue
+		}
 		item, err := capdata.DecodeSerializedCapdata(capDataJson, valueTransformations)
 		if err != nil {
 			return nil, status.Error(codes.FailedPrecondition, err.Error())
 		}
+		if selector != nil {
+			item, err = selector.Select(item)
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+		}
 		if transformation == FormatCapDataFlat {
 			flattened := map[string]interface{}{}
 			if err := flatten(item, flattened, "", true); err != nil {
@@ -226,19 +418,33 @@ func (k Querier) CapData(c context.Context, req *types.QueryCapDataRequest) (*ty
 				item = flattened
 			}
 		}
-		switch mediaType {
-		case JSONLines:
+		items[i] = item
+	}
+
+	// Format the items.
+	var formatted string
+	switch mediaType {
+	case JSONLines:
+		lines := make([]string, len(items))
+		for i, item := range items {
 			jsonText, err := capdata.JsonMarshal(item)
 			if err != nil {
 				return nil, status.Error(codes.Internal, err.Error())
 			}
-			responseItems[i] = string(jsonText)
+			lines[i] = string(jsonText)
+		}
+		formatted = strings.Join(lines, "\n")
+	case CSV, TSV:
+		var err error
+		formatted, err = formatDelimitedValues(items, capDataDelimiters[mediaType])
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
 		}
 	}
 
 	return &types.QueryCapDataResponse{
 		BlockHeight: cell.BlockHeight,
-		Value:       prefix + strings.Join(responseItems, separator) + suffix,
+		Value:       formatted,
 	}, nil
 }
 
@@ -262,3 +468,237 @@ func (k Querier) Children(c context.Context, req *types.QueryChildrenRequest) (*
 		Children: children.Children,
 	}, nil
 }
+
+// ===================================================================
+// /agoric.vstorage.Query/Entries
+// ===================================================================
+
+// /agoric.vstorage.Query/Entries returns the path segments that exist
+// immediately underneath a specified path along with the data of each,
+// like Children and Data combined, and whether each has children of its own.
+func (k Querier) Entries(c context.Context, req *types.QueryEntriesRequest) (*types.QueryEntriesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := types.ValidatePath(req.Path); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	prefix := ""
+	if req.Path != "" {
+		prefix = req.Path + types.PathSeparator
+	}
+	entries := []types.ChildEntry{}
+	pageRes, err := k.PaginateChildren(ctx, req.Path, req.Pagination, func(entry agoric.KVEntry) error {
+		entries = append(entries, types.ChildEntry{
+			Child:       entry.Key(),
+			HasValue:    entry.HasValue(),
+			Value:       entry.StringValue(),
+			HasChildren: k.HasChildren(ctx, prefix+entry.Key()),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryEntriesResponse{
+		Entries:    entries,
+		Pagination: pageRes,
+	}, nil
+}
+
+// ===================================================================
+// /agoric.vstorage.Query/Values
+// ===================================================================
+
+// /agoric.vstorage.Query/Values returns the data of each child of a
+// specified path, in the same order as Children.
+// As with Data, a child with no data is represented by an empty string.
+func (k Querier) Values(c context.Context, req *types.QueryValuesRequest) (*types.QueryValuesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := types.ValidatePath(req.Path); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	values := []string{}
+	pageRes, err := k.PaginateChildren(ctx, req.Path, req.Pagination, func(entry agoric.KVEntry) error {
+		values = append(values, entry.StringValue())
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryValuesResponse{
+		Values:     values,
+		Pagination: pageRes,
+	}, nil
+}
+
+// ===================================================================
+// /agoric.vstorage.Query/Size
+// ===================================================================
+
+// /agoric.vstorage.Query/Size returns the count of path segments that
+// exist immediately underneath a specified path.
+func (k Querier) Size(c context.Context, req *types.QuerySizeRequest) (*types.QuerySizeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := types.ValidatePath(req.Path); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QuerySizeResponse{
+		Size_: k.CountChildren(ctx, req.Path),
+	}, nil
+}
+
+// ===================================================================
+// /agoric.vstorage.Query/StreamCellHistory
+// ===================================================================
+
+// /agoric.vstorage.Query/StreamCellHistory returns the StreamCells written
+// at a specified path within a range of block heights, as retained by this
+// node since it enabled StreamCell history for the path, along with the
+// earliest block height it has retained for the path.
+func (k Querier) StreamCellHistory(c context.Context, req *types.QueryStreamCellHistoryRequest) (*types.QueryStreamCellHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := types.ValidatePath(req.Path); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.MinHeight < 0 || req.MaxHeight < 0 {
+		return nil, status.Error(codes.InvalidArgument, "negative block height")
+	}
+	if req.MaxHeight != 0 && req.MaxHeight < req.MinHeight {
+		return nil, status.Error(codes.InvalidArgument, "max_height is less than min_height")
+	}
+	history := k.GetStreamCellHistory()
+	if history == nil || !history.Retains(req.Path) {
+		return nil, status.Error(codes.Unimplemented, "StreamCell history is not retained for path")
+	}
+
+	cells := []types.StreamCell{}
+	pageRes, err := history.Paginate(req.Path, req.MinHeight, req.MaxHeight, req.Pagination, func(cell StreamCell) error {
+		cells = append(cells, types.StreamCell{
+			BlockHeight: cell.BlockHeight,
+			Values:      cell.Values,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	earliestHeight, err := history.EarliestHeight(req.Path)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryStreamCellHistoryResponse{
+		Cells:          cells,
+		Pagination:     pageRes,
+		EarliestHeight: earliestHeight,
+	}, nil
+}
+
+// ===================================================================
+// /agoric.vstorage.Query/Params
+// ===================================================================
+
+// /agoric.vstorage.Query/Params returns the module parameters.
+func (k Querier) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{
+		Params: k.GetParams(ctx),
+	}, nil
+}
+
+// ===================================================================
+// /agoric.vstorage.Query/Usage
+// ===================================================================
+
+// /agoric.vstorage.Query/Usage returns the bytes used under each top-level
+// path, as limited by the max_subtree_bytes parameter.
+func (k Querier) Usage(c context.Context, req *types.QueryUsageRequest) (*types.QueryUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	usage := []types.SubtreeUsage{}
+	pageRes, err := k.PaginateSubtreeUsage(ctx, req.Pagination, func(subtreeUsage types.SubtreeUsage) error {
+		usage = append(usage, subtreeUsage)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryUsageResponse{
+		Usage:      usage,
+		Pagination: pageRes,
+	}, nil
+}
+
+// ===================================================================
+// /agoric.vstorage.Query/Watch
+// ===================================================================
+
+// /agoric.vstorage.Query/Watch streams the changes to a specified path (and
+// optionally its descendants) as their blocks are committed, starting from a
+// block height retained by this node's ChangeFeed.
+func (k Querier) Watch(req *types.QueryWatchRequest, stream types.Query_WatchServer) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := types.ValidatePath(req.Path); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.FromHeight < 0 {
+		return status.Error(codes.InvalidArgument, "negative block height")
+	}
+	feed := k.GetChangeFeed()
+	if feed == nil {
+		return status.Error(codes.Unimplemented, "watching is not enabled")
+	}
+
+	height := req.FromHeight
+	for {
+		updates, next, nextCommit, err := feed.Since(height)
+		if err != nil {
+			return status.Error(codes.OutOfRange, err.Error())
+		}
+		for _, update := range updates {
+			if !WatchMatches(req.Path, req.Recursive, update.Path) {
+				continue
+			}
+			err := stream.Send(&types.QueryWatchResponse{
+				BlockHeight: update.BlockHeight,
+				Path:        update.Path,
+				Value:       update.Value,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		height = next
+
+		select {
+		case <-nextCommit:
+		case <-stream.Context().Done():
+			return status.FromContextError(stream.Context().Err()).Err()
+		}
+	}
+}
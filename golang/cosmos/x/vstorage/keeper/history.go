@@ -0,0 +1,214 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/types"
+)
+
+// StreamCellHistory is an opt-in record of the StreamCells written at selected
+// vstorage paths, retained by a node so that its clients can backfill values
+// that were overwritten in later blocks.
+// It is node-local rather than part of consensus state, and is fed from the
+// same changes that EmitChange reports as state_change events, so it only
+// covers blocks executed by the node after the history was enabled.
+//
+// Cells are keyed by path followed by a nul separator and the big-endian
+// block height, so that each path's cells iterate in block height order.
+// The block height from which each path prefix has been retained is keyed by
+// streamCellHistoryStartPrefix followed by the path prefix.
+type StreamCellHistory struct {
+	db           dbm.DB
+	pathPrefixes []string
+	// started tells if every path prefix has a recorded start height.
+	started bool
+}
+
+// streamCellHistoryStartPrefix cannot begin a StreamCell key, because "#" is
+// not valid in a vstorage path.
+var streamCellHistoryStartPrefix = []byte("#start\x00")
+
+// NewStreamCellHistory returns a StreamCellHistory that records into db the
+// StreamCells written at each of pathPrefixes and their descendants.
+// An empty pathPrefix retains every path.
+func NewStreamCellHistory(db dbm.DB, pathPrefixes []string) *StreamCellHistory {
+	return &StreamCellHistory{db: db, pathPrefixes: pathPrefixes}
+}
+
+// Retains tells if the history records StreamCells written at a given path.
+func (h *StreamCellHistory) Retains(path string) bool {
+	for _, pathPrefix := range h.pathPrefixes {
+		if pathPrefixRetains(pathPrefix, path) {
+			return true
+		}
+	}
+	return false
+}
+
+func pathPrefixRetains(pathPrefix, path string) bool {
+	return pathPrefix == "" || path == pathPrefix ||
+		strings.HasPrefix(path, pathPrefix+types.PathSeparator)
+}
+
+func streamCellHistoryStartKey(pathPrefix string) []byte {
+	return append(append([]byte{}, streamCellHistoryStartPrefix...), pathPrefix...)
+}
+
+// Start records blockHeight as the height from which each path prefix is
+// retained, unless an earlier one was recorded by a previous run of the node.
+func (h *StreamCellHistory) Start(blockHeight int64) error {
+	if h.started {
+		return nil
+	}
+	for _, pathPrefix := range h.pathPrefixes {
+		key := streamCellHistoryStartKey(pathPrefix)
+		has, err := h.db.Has(key)
+		if err != nil {
+			return err
+		}
+		if has {
+			continue
+		}
+		err = h.db.Set(key, binary.BigEndian.AppendUint64(nil, uint64(blockHeight)))
+		if err != nil {
+			return err
+		}
+	}
+	h.started = true
+	return nil
+}
+
+// EarliestHeight returns the lowest block height from which the StreamCells
+// written at a path have been retained, or 0 if they are not yet retained.
+// The history is never backfilled, so earlier cells are not available.
+func (h *StreamCellHistory) EarliestHeight(path string) (int64, error) {
+	earliest := int64(0)
+	for _, pathPrefix := range h.pathPrefixes {
+		if !pathPrefixRetains(pathPrefix, path) {
+			continue
+		}
+		bz, err := h.db.Get(streamCellHistoryStartKey(pathPrefix))
+		if err != nil {
+			return 0, err
+		}
+		if bz == nil {
+			continue
+		}
+		height := int64(binary.BigEndian.Uint64(bz))
+		if earliest == 0 || height < earliest {
+			earliest = height
+		}
+	}
+	return earliest, nil
+}
+
+// Close closes the database of the history.
+func (h *StreamCellHistory) Close() error {
+	return h.db.Close()
+}
+
+func streamCellHistoryPathPrefix(path string) []byte {
+	return append([]byte(path), types.EncodedKeySeparator...)
+}
+
+func streamCellHistoryKey(path string, blockHeight int64) []byte {
+	key := streamCellHistoryPathPrefix(path)
+	return binary.BigEndian.AppendUint64(key, uint64(blockHeight))
+}
+
+// Record saves the StreamCell written at a path in a block, replacing any
+// previous record for the same block (as happens when a block is replayed).
+func (h *StreamCellHistory) Record(path string, blockHeight int64, cell StreamCell) error {
+	bz, err := json.Marshal(cell)
+	if err != nil {
+		return err
+	}
+	return h.db.Set(streamCellHistoryKey(path, blockHeight), bz)
+}
+
+// Paginate calls onResult with each StreamCell recorded at a path in a block
+// whose height is at least minHeight and (if maxHeight is nonzero) at most
+// maxHeight, subject to pageRequest.
+func (h *StreamCellHistory) Paginate(
+	path string,
+	minHeight, maxHeight int64,
+	pageRequest *query.PageRequest,
+	onResult func(cell StreamCell) error,
+) (*query.PageResponse, error) {
+	store := prefix.NewStore(dbadapter.Store{DB: h.db}, streamCellHistoryPathPrefix(path))
+	return query.FilteredPaginate(store, pageRequest, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		blockHeight := int64(binary.BigEndian.Uint64(key))
+		if blockHeight < minHeight || (maxHeight != 0 && blockHeight > maxHeight) {
+			return false, nil
+		}
+		if accumulate {
+			var cell StreamCell
+			if err := json.Unmarshal(value, &cell); err != nil {
+				return false, err
+			}
+			if err := onResult(cell); err != nil {
+				return false, err
+			}
+		}
+		return true, nil
+	})
+}
+
+// SetStreamCellHistory enables retention of StreamCell history.
+// It must be called before the Keeper is copied into any other component.
+func (k *Keeper) SetStreamCellHistory(history *StreamCellHistory) {
+	k.streamCellHistory = history
+}
+
+// GetStreamCellHistory returns the retained StreamCell history, or nil if
+// retention is not enabled.
+func (k Keeper) GetStreamCellHistory() *StreamCellHistory {
+	return k.streamCellHistory
+}
+
+// startStreamCellHistory records the current block height as the start of the
+// StreamCell history if it is enabled and has not already started.
+func (k Keeper) startStreamCellHistory(ctx sdk.Context) {
+	history := k.streamCellHistory
+	if history == nil {
+		return
+	}
+
+	// The history is not consensus-critical, so log rather than halt.
+	if err := history.Start(ctx.BlockHeight()); err != nil {
+		ctx.Logger().Error("failed to start StreamCell history", "err", err)
+	}
+}
+
+// recordStreamCellHistory saves a change to the StreamCell history if it is
+// enabled and retains the changed path, and the new value is a StreamCell
+// written in the current block.
+func (k Keeper) recordStreamCellHistory(ctx sdk.Context, change *ProposedChange) {
+	history := k.streamCellHistory
+	if history == nil || !history.Retains(change.Path) {
+		return
+	}
+
+	var cell StreamCell
+	if err := json.Unmarshal([]byte(change.NewValue), &cell); err != nil {
+		return
+	}
+	blockHeight := ctx.BlockHeight()
+	if cell.BlockHeight != strconv.FormatInt(blockHeight, 10) {
+		return
+	}
+
+	// The history is not consensus-critical, so log rather than halt.
+	if err := history.Record(change.Path, blockHeight, cell); err != nil {
+		ctx.Logger().Error("failed to record StreamCell history", "path", change.Path, "err", err)
+	}
+}
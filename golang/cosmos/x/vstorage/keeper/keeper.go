@@ -11,8 +11,11 @@ import (
 	"strings"
 
 	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	db "github.com/tendermint/tm-db"
 
 	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
@@ -55,8 +58,12 @@ var MaxSDKInt = sdk.NewIntFromBigInt(new(big.Int).Sub(new(big.Int).Exp(big.NewIn
 // Keeper maintains the link to data storage and exposes getter/setter methods
 // for the various parts of the state machine
 type Keeper struct {
-	changeManager ChangeManager
-	storeKey      storetypes.StoreKey
+	changeManager     ChangeManager
+	storeKey          storetypes.StoreKey
+	metaStoreKey      storetypes.StoreKey
+	paramSpace        paramtypes.Subspace
+	streamCellHistory *StreamCellHistory
+	changeFeed        *ChangeFeed
 }
 
 func (bcm *BatchingChangeManager) Track(ctx sdk.Context, k Keeper, entry agoric.KVEntry, isLegacy bool) {
@@ -110,9 +117,18 @@ func NewBatchingChangeManager() *BatchingChangeManager {
 	return &bcm
 }
 
-func NewKeeper(storeKey storetypes.StoreKey) Keeper {
+// NewKeeper returns a Keeper of the paths in the storeKey store, with its
+// bookkeeping in the metaStoreKey store.
+func NewKeeper(storeKey storetypes.StoreKey, metaStoreKey storetypes.StoreKey, paramSpace paramtypes.Subspace) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return Keeper{
 		storeKey:      storeKey,
+		metaStoreKey:  metaStoreKey,
+		paramSpace:    paramSpace,
 		changeManager: NewBatchingChangeManager(),
 	}
 }
@@ -124,23 +140,15 @@ func (k Keeper) ExportStorage(ctx sdk.Context) []*types.DataEntry {
 
 // ExportStorageFromPrefix fetches storage only under the supplied pathPrefix.
 func (k Keeper) ExportStorageFromPrefix(ctx sdk.Context, pathPrefix string) []*types.DataEntry {
-	store := ctx.KVStore(k.storeKey)
-
+	if err := types.ValidatePath(pathPrefix); err != nil {
+		panic(err)
+	}
+	descendantPrefix := pathPrefix
 	if len(pathPrefix) > 0 {
-		if err := types.ValidatePath(pathPrefix); err != nil {
-			panic(err)
-		}
-		pathPrefix = pathPrefix + types.PathSeparator
+		descendantPrefix = pathPrefix + types.PathSeparator
 	}
 
-	// since vstorage encodes keys with a prefix indicating the number of path
-	// elements, exporting all entries under a given path cannot use a prefix
-	// iterator. Instead we iterate over the whole vstorage content and check
-	// whether each entry matches the path prefix. This choice assumes most
-	// entries will be exported. An alternative implementation would be to
-	// recursively list all children under the pathPrefix, and export them.
-
-	iterator := sdk.KVStorePrefixIterator(store, nil)
+	iterator := k.newDescendantIterator(ctx, pathPrefix)
 
 	exported := []*types.DataEntry{}
 	defer iterator.Close()
@@ -153,14 +161,11 @@ func (k Keeper) ExportStorageFromPrefix(ctx sdk.Context, pathPrefix string) []*t
 			continue
 		}
 		path := types.EncodedKeyToPath(iterator.Key())
-		if !strings.HasPrefix(path, pathPrefix) {
-			continue
-		}
 		value, hasPrefix := bytes.CutPrefix(rawValue, types.EncodedDataPrefix)
 		if !hasPrefix {
 			panic(fmt.Errorf("value at path %q starts with unexpected prefix", path))
 		}
-		path = path[len(pathPrefix):]
+		path = path[len(descendantPrefix):]
 		entry := types.DataEntry{Path: path, Value: string(value)}
 		exported = append(exported, &entry)
 	}
@@ -175,19 +180,6 @@ func (k Keeper) ImportStorage(ctx sdk.Context, entries []*types.DataEntry) {
 	}
 }
 
-func getEncodedKeysWithPrefixFromIterator(iterator sdk.Iterator, prefix string) [][]byte {
-	keys := make([][]byte, 0)
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		key := iterator.Key()
-		path := types.EncodedKeyToPath(key)
-		if strings.HasPrefix(path, prefix) {
-			keys = append(keys, key)
-		}
-	}
-	return keys
-}
-
 // RemoveEntriesWithPrefix removes all storage entries starting with the
 // supplied pathPrefix, which may not be empty.
 // It has the same effect as listing children of the prefix and removing each
@@ -201,22 +193,25 @@ func (k Keeper) RemoveEntriesWithPrefix(ctx sdk.Context, pathPrefix string) {
 	if err := types.ValidatePath(pathPrefix); err != nil {
 		panic(err)
 	}
-	descendantPrefix := pathPrefix + types.PathSeparator
-
-	// since vstorage encodes keys with a prefix indicating the number of path
-	// elements, we cannot use a simple prefix iterator.
-	// Instead we iterate over the whole vstorage content and check
-	// whether each entry matches the descendantPrefix. This choice assumes most
-	// entries will be deleted. An alternative implementation would be to
-	// recursively list all children under the descendantPrefix, and delete them.
-
-	iterator := sdk.KVStorePrefixIterator(store, nil)
 
-	keys := getEncodedKeysWithPrefixFromIterator(iterator, descendantPrefix)
+	// Collect the keys before deleting any, since a store must not be
+	// modified while it is being iterated.
+	iterator := k.newDescendantIterator(ctx, pathPrefix)
+	keys := make([][]byte, 0)
+	var removedBytes uint64
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		keys = append(keys, key)
+		removedBytes += entryBytes(rawValueToEntry(types.EncodedKeyToPath(key), iterator.Value()))
+	}
+	iterator.Close()
 
 	for _, key := range keys {
 		store.Delete(key)
+		k.deleteChildCount(ctx, key)
 	}
+	k.deleteChildCount(ctx, types.PathToEncodedKey(pathPrefix))
+	k.updateSubtreeBytes(ctx, pathPrefix, removedBytes, 0)
 
 	// Update the prefix entry itself with SetStorage, which will effectively
 	// delete it and all necessary ancestors.
@@ -244,6 +239,9 @@ func (k Keeper) EmitChange(ctx sdk.Context, change *ProposedChange) {
 			[]byte(change.NewValue),
 		),
 	)
+
+	k.recordStreamCellHistory(ctx, change)
+	k.stageWatchUpdate(ctx, change)
 }
 
 // GetEntry gets generic storage.  The default value is an empty string.
@@ -252,6 +250,11 @@ func (k Keeper) GetEntry(ctx sdk.Context, path string) agoric.KVEntry {
 	store := ctx.KVStore(k.storeKey)
 	encodedKey := types.PathToEncodedKey(path)
 	rawValue := store.Get(encodedKey)
+	return rawValueToEntry(path, rawValue)
+}
+
+// rawValueToEntry decodes a raw store value into an entry for the given path.
+func rawValueToEntry(path string, rawValue []byte) agoric.KVEntry {
 	if len(rawValue) == 0 {
 		return agoric.NewKVEntryWithNoValue(path)
 	}
@@ -272,6 +275,143 @@ func (k Keeper) getKeyIterator(ctx sdk.Context, path string) db.Iterator {
 	return sdk.KVStorePrefixIterator(store, keyPrefix)
 }
 
+var _ db.Iterator = &descendantIterator{}
+
+// descendantIterator iterates over the store entries of every descendant of a
+// path, in order of increasing depth and then by key.
+// Since each encoded key starts with its depth, the descendants of a path do
+// not share a prefix. Instead, the iterator scans the prefix of each depth
+// below the path in turn, stopping after the first depth with no entries
+// (because an entry exists only if it or some descendant has data).
+// This makes the cost proportional to the size of the subtree rather than
+// that of the whole store.
+type descendantIterator struct {
+	store       sdk.KVStore
+	path        string
+	generations int
+	current     db.Iterator
+}
+
+// newDescendantIterator returns an iterator over the descendants of a path,
+// not including the path itself.
+func (k Keeper) newDescendantIterator(ctx sdk.Context, path string) db.Iterator {
+	di := &descendantIterator{
+		store: ctx.KVStore(k.storeKey),
+		path:  path,
+	}
+	di.nextGeneration()
+	return di
+}
+
+// nextGeneration replaces the current iterator with one over the next
+// generation of descendants, or nil if the current generation is empty.
+func (di *descendantIterator) nextGeneration() {
+	if di.current != nil {
+		di.current.Close()
+	}
+	di.generations += 1
+	keyPrefix := types.PathToDescendantsPrefix(di.path, di.generations)
+	di.current = sdk.KVStorePrefixIterator(di.store, keyPrefix)
+	if !di.current.Valid() {
+		di.current.Close()
+		di.current = nil
+	}
+}
+
+// Domain is unbounded, since descendants do not share a prefix.
+// Implements db.Iterator
+func (di *descendantIterator) Domain() (start []byte, end []byte) {
+	return nil, nil
+}
+
+// Implements db.Iterator
+func (di *descendantIterator) Valid() bool {
+	return di.current != nil && di.current.Valid()
+}
+
+// Implements db.Iterator
+func (di *descendantIterator) Next() {
+	di.current.Next()
+	if !di.current.Valid() {
+		di.nextGeneration()
+	}
+}
+
+// Implements db.Iterator
+func (di *descendantIterator) Key() []byte {
+	return di.current.Key()
+}
+
+// Implements db.Iterator
+func (di *descendantIterator) Value() []byte {
+	return di.current.Value()
+}
+
+// Implements db.Iterator
+func (di *descendantIterator) Error() error {
+	if di.current == nil {
+		return nil
+	}
+	return di.current.Error()
+}
+
+// Implements db.Iterator
+func (di *descendantIterator) Close() error {
+	if di.current == nil {
+		return nil
+	}
+	err := di.current.Close()
+	di.current = nil
+	return err
+}
+
+// getChildrenStore returns a view of the store restricted to the immediate
+// children of a given path, in which each key is a child path segment.
+func (k Keeper) getChildrenStore(ctx sdk.Context, path string) prefix.Store {
+	store := ctx.KVStore(k.storeKey)
+	keyPrefix := types.PathToChildrenPrefix(path)
+
+	return prefix.NewStore(store, keyPrefix)
+}
+
+// PaginateChildren calls onResult with the entry of each child of a given
+// path, in the same order as GetChildren, subject to pageRequest.
+// Each entry key is a child path segment rather than a full path.
+func (k Keeper) PaginateChildren(
+	ctx sdk.Context,
+	path string,
+	pageRequest *query.PageRequest,
+	onResult func(entry agoric.KVEntry) error,
+) (*query.PageResponse, error) {
+	store := k.getChildrenStore(ctx, path)
+	return query.Paginate(store, pageRequest, func(key []byte, rawValue []byte) error {
+		child := string(key)
+		return onResult(rawValueToEntry(child, rawValue))
+	})
+}
+
+// FilteredPaginateChildren is like PaginateChildren, but onResult is called
+// with accumulate false for the entries that would fall outside the page and
+// reports whether the entry matched, so that pages and totals count only
+// matching entries.
+func (k Keeper) FilteredPaginateChildren(
+	ctx sdk.Context,
+	path string,
+	pageRequest *query.PageRequest,
+	onResult func(entry agoric.KVEntry, accumulate bool) (bool, error),
+) (*query.PageResponse, error) {
+	store := k.getChildrenStore(ctx, path)
+	return query.FilteredPaginate(store, pageRequest, func(key []byte, rawValue []byte, accumulate bool) (bool, error) {
+		child := string(key)
+		return onResult(rawValueToEntry(child, rawValue), accumulate)
+	})
+}
+
+// CountChildren returns the number of children of a given path.
+func (k Keeper) CountChildren(ctx sdk.Context, path string) uint64 {
+	return k.getChildCount(ctx, types.PathToEncodedKey(path))
+}
+
 // GetChildren gets all vstorage child children at a given path
 func (k Keeper) GetChildren(ctx sdk.Context, path string) *types.Children {
 	iterator := k.getKeyIterator(ctx, path)
@@ -316,6 +456,7 @@ func (k Keeper) NewChangeBatch(ctx sdk.Context) {
 }
 
 func (k Keeper) FlushChangeEvents(ctx sdk.Context) {
+	k.startStreamCellHistory(ctx)
 	k.changeManager.EmitEvents(ctx, k)
 	k.changeManager.Rollback(ctx)
 }
@@ -331,6 +472,17 @@ func (k Keeper) LegacySetStorageAndNotify(ctx sdk.Context, entry agoric.KVEntry)
 }
 
 func (k Keeper) AppendStorageValueAndNotify(ctx sdk.Context, path, value string) error {
+	entry, err := k.AppendedStorageEntry(ctx, path, value)
+	if err != nil {
+		return err
+	}
+	k.SetStorageAndNotify(ctx, entry)
+	return nil
+}
+
+// AppendedStorageEntry returns the entry that AppendStorageValueAndNotify
+// would write to append a value to the StreamCell at a path.
+func (k Keeper) AppendedStorageEntry(ctx sdk.Context, path, value string) (agoric.KVEntry, error) {
 	blockHeight := strconv.FormatInt(ctx.BlockHeight(), 10)
 
 	// Preserve correctly-formatted data within the current block,
@@ -345,13 +497,11 @@ func (k Keeper) AppendStorageValueAndNotify(ctx sdk.Context, path, value string)
 	// Append the new value.
 	cell.Values = append(cell.Values, value)
 
-	// Perform the write.
 	bz, err := json.Marshal(cell)
 	if err != nil {
-		return err
+		return agoric.KVEntry{}, err
 	}
-	k.SetStorageAndNotify(ctx, agoric.NewKVEntry(path, string(bz)))
-	return nil
+	return agoric.NewKVEntry(path, string(bz)), nil
 }
 
 func componentsToPath(components []string) string {
@@ -367,10 +517,16 @@ func (k Keeper) SetStorage(ctx sdk.Context, entry agoric.KVEntry) {
 	path := entry.Key()
 	encodedKey := types.PathToEncodedKey(path)
 
+	k.updateSubtreeBytes(ctx, path, entryBytes(k.GetEntry(ctx, path)), entryBytes(entry))
+
+	existed := store.Has(encodedKey)
 	if !entry.HasValue() {
 		if !k.HasChildren(ctx, path) {
 			// We have no children, can delete.
 			store.Delete(encodedKey)
+			if existed {
+				k.removedPath(ctx, path)
+			}
 		} else {
 			store.Set(encodedKey, types.EncodedNoDataValue)
 		}
@@ -378,6 +534,9 @@ func (k Keeper) SetStorage(ctx sdk.Context, entry agoric.KVEntry) {
 		// Update the value.
 		bz := bytes.Join([][]byte{types.EncodedDataPrefix, []byte(entry.StringValue())}, []byte{})
 		store.Set(encodedKey, bz)
+		if !existed {
+			k.addedPath(ctx, path)
+		}
 	}
 
 	// Update our other parent children.
@@ -390,7 +549,11 @@ func (k Keeper) SetStorage(ctx sdk.Context, entry agoric.KVEntry) {
 				// this and further ancestors are needed, skip out
 				break
 			}
-			store.Delete(types.PathToEncodedKey(ancestor))
+			ancestorKey := types.PathToEncodedKey(ancestor)
+			if store.Has(ancestorKey) {
+				store.Delete(ancestorKey)
+				k.removedPath(ctx, ancestor)
+			}
 		}
 	} else {
 		// add placeholders as needed
@@ -401,6 +564,7 @@ func (k Keeper) SetStorage(ctx sdk.Context, entry agoric.KVEntry) {
 				break
 			}
 			store.Set(types.PathToEncodedKey(ancestor), types.EncodedNoDataValue)
+			k.addedPath(ctx, ancestor)
 		}
 	}
 }
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// populateBenchmarkStorage fills storage with wallets under
+// "published.wallet", each having a few children, plus a small
+// "published.priceFeed" subtree.
+func populateBenchmarkStorage(tk testKit, wallets int) {
+	for i := 0; i < wallets; i++ {
+		wallet := fmt.Sprintf("published.wallet.agoric1wallet%06d", i)
+		tk.vstorageKeeper.SetStorage(tk.ctx, agoric.NewKVEntry(wallet, "{}"))
+		tk.vstorageKeeper.SetStorage(tk.ctx, agoric.NewKVEntry(wallet+".current", "{}"))
+	}
+	for i := 0; i < 10; i++ {
+		feed := fmt.Sprintf("published.priceFeed.ASSET%d-USD_price_feed", i)
+		tk.vstorageKeeper.SetStorage(tk.ctx, agoric.NewKVEntry(feed, "{}"))
+		tk.vstorageKeeper.SetStorage(tk.ctx, agoric.NewKVEntry(feed+".latestRound", "{}"))
+	}
+	// Commit so that iteration reflects persisted state rather than the
+	// unsaved writes of the current block.
+	tk.ctx.MultiStore().(storetypes.CommitMultiStore).Commit()
+}
+
+// BenchmarkExportSmallSubtree measures exporting a fixed-size subtree from
+// stores of increasing size, which should not grow with the store.
+func BenchmarkExportSmallSubtree(b *testing.B) {
+	for _, wallets := range []int{100, 1000, 10000} {
+		b.Run(fmt.Sprintf("wallets=%d", wallets), func(b *testing.B) {
+			tk := makeTestKit()
+			populateBenchmarkStorage(tk, wallets)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if exported := tk.vstorageKeeper.ExportStorageFromPrefix(tk.ctx, "published.priceFeed"); len(exported) != 20 {
+					b.Fatalf("got %d entries, want 20", len(exported))
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkRemoveSmallSubtree measures removing a fixed-size subtree from
+// stores of increasing size, which should not grow with the store.
+func BenchmarkRemoveSmallSubtree(b *testing.B) {
+	for _, wallets := range []int{100, 1000, 10000} {
+		b.Run(fmt.Sprintf("wallets=%d", wallets), func(b *testing.B) {
+			tk := makeTestKit()
+			populateBenchmarkStorage(tk, wallets)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				ctx, _ := tk.ctx.CacheContext()
+				b.StartTimer()
+				tk.vstorageKeeper.RemoveEntriesWithPrefix(ctx, "published.priceFeed")
+			}
+		})
+	}
+}
+
+// BenchmarkRemoveWallet measures removing a single wallet from stores of
+// increasing size.
+func BenchmarkRemoveWallet(b *testing.B) {
+	for _, wallets := range []int{100, 1000, 10000} {
+		b.Run(fmt.Sprintf("wallets=%d", wallets), func(b *testing.B) {
+			tk := makeTestKit()
+			populateBenchmarkStorage(tk, wallets)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				ctx, _ := tk.ctx.CacheContext()
+				b.StartTimer()
+				tk.vstorageKeeper.RemoveEntriesWithPrefix(ctx, "published.wallet.agoric1wallet000042")
+			}
+		})
+	}
+}
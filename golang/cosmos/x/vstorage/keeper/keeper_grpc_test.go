@@ -1,11 +1,13 @@
 package keeper
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"strings"
 	"testing"
 
+	"google.golang.org/grpc"
 	grpcCodes "google.golang.org/grpc/codes"
 	grpcStatus "google.golang.org/grpc/status"
 
@@ -14,6 +16,8 @@ import (
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	dbm "github.com/tendermint/tm-db"
 )
 
 func ptr[T any](v T) *T {
@@ -234,8 +238,146 @@ func TestCapData(t *testing.T) {
 		},
 	})
 
-	// Test errors from CapData that includes unsupported values.
-	expectNotImplemented := func(label, capdataBody string, slots []any) testCase {
+	testCases = append(testCases, testCase{label: "remotables as slots",
+		data:    ptr(cell),
+		request: types.QueryCapDataRequest{RemotableValueFormat: "slot"},
+		expected: types.QueryCapDataResponse{
+			BlockHeight: "1",
+			Value: mustMarshalTwoLines(map[string]any{
+				"arr": []any{
+					map[string]any{
+						"bigint":    "42",
+						"remotable": map[string]any{"slot": "a", "iface": "Alleged: Foo brand"},
+						"ref2":      map[string]any{"slot": "a", "iface": "Alleged: Foo brand"},
+					},
+				},
+			}),
+		},
+	})
+	testCases = append(testCases, []testCase{
+		{label: "CSV, remotables as slots",
+			data:    ptr(cell),
+			request: types.QueryCapDataRequest{MediaType: "text/csv", ItemFormat: "flat", RemotableValueFormat: "slot"},
+			expected: types.QueryCapDataResponse{
+				BlockHeight: "1",
+				Value: "arr-0-bigint,arr-0-ref2-iface,arr-0-ref2-slot,arr-0-remotable-iface,arr-0-remotable-slot\n" +
+					"42,Alleged: Foo brand,a,Alleged: Foo brand,a\n" +
+					"42,Alleged: Foo brand,a,Alleged: Foo brand,a\n",
+			},
+		},
+		{label: "TSV, remotables as strings",
+			data:    ptr(cell),
+			request: types.QueryCapDataRequest{MediaType: "text/tab-separated-values", ItemFormat: "flat", RemotableValueFormat: "string"},
+			expected: types.QueryCapDataResponse{
+				BlockHeight: "1",
+				Value: "arr-0-bigint\tarr-0-ref2\tarr-0-remotable\n" +
+					"42\t[Alleged: Foo brand <a>]\t[Alleged: Foo brand <a>]\n" +
+					"42\t[Alleged: Foo brand <a>]\t[Alleged: Foo brand <a>]\n",
+			},
+		},
+		{label: "CSV of heterogeneous items",
+			data: ptr(mustMarshalStreamCell("1", []string{
+				`{"body":"#{\"a\":\"x,y\",\"b\":true}","slots":[]}`,
+				`{"body":"#{\"b\":null,\"c\":[1]}","slots":[]}`,
+			})),
+			request: types.QueryCapDataRequest{MediaType: "text/csv", ItemFormat: "flat", RemotableValueFormat: "string"},
+			expected: types.QueryCapDataResponse{
+				BlockHeight: "1",
+				Value:       "a,b,c-0\n\"x,y\",true,\n,,1\n",
+			},
+		},
+		{label: "TSV with escapes",
+			data: ptr(mustMarshalStreamCell("1", []string{
+				`{"body":"#{\"a\":\"x\\ty\\nz\",\"b\":\"back\\\\slash\"}","slots":[]}`,
+			})),
+			request: types.QueryCapDataRequest{MediaType: "text/tab-separated-values", ItemFormat: "flat", RemotableValueFormat: "string"},
+			expected: types.QueryCapDataResponse{
+				BlockHeight: "1",
+				Value:       "a\tb\n" + `x\ty\nz` + "\t" + `back\\slash` + "\n",
+			},
+		},
+		{label: "CSV of scalars",
+			data:    ptr(mustMarshalStreamCell("1", []string{`{"body":"#\"+42\"","slots":[]}`, `{"body":"#\"a,b\"","slots":[]}`})),
+			request: types.QueryCapDataRequest{MediaType: "text/csv", ItemFormat: "flat", RemotableValueFormat: "string"},
+			expected: types.QueryCapDataResponse{
+				BlockHeight: "1",
+				Value:       "$\n42\n\"a,b\"\n",
+			},
+		},
+		{label: "CSV without flat",
+			data:        ptr(cell),
+			request:     types.QueryCapDataRequest{MediaType: "text/csv", RemotableValueFormat: "string"},
+			errCode:     grpcCodes.InvalidArgument,
+			errContains: ptr("item_format"),
+		},
+		{label: "selected remotable, flat",
+			data:    ptr(cell),
+			request: types.QueryCapDataRequest{Selector: "$.arr[0].remotable", ItemFormat: "flat", RemotableValueFormat: "object"},
+			expected: types.QueryCapDataResponse{
+				BlockHeight: "1",
+				Value:       mustMarshalTwoLines(map[string]any{"allegedName": "Foo brand", "id": "a"}),
+			},
+		},
+		{label: "selected through remotable",
+			data:    ptr(cell),
+			request: types.QueryCapDataRequest{Selector: "arr[*].ref2.id", RemotableValueFormat: "object"},
+			expected: types.QueryCapDataResponse{
+				BlockHeight: "1",
+				Value:       mustMarshalTwoLines([]any{"a"}),
+			},
+		},
+		{label: "selected scalar, CSV",
+			data:    ptr(cell),
+			request: types.QueryCapDataRequest{Selector: "arr[0].bigint", MediaType: "text/csv", ItemFormat: "flat", RemotableValueFormat: "string"},
+			expected: types.QueryCapDataResponse{
+				BlockHeight: "1",
+				Value:       "$\n42\n42\n",
+			},
+		},
+		{label: "selected nothing",
+			data:    ptr(cell),
+			request: types.QueryCapDataRequest{Selector: "arr[1]", RemotableValueFormat: "string"},
+			expected: types.QueryCapDataResponse{
+				BlockHeight: "1",
+				Value:       "null\nnull",
+			},
+		},
+		{label: "invalid selector",
+			data:        ptr(cell),
+			request:     types.QueryCapDataRequest{Selector: "arr[", RemotableValueFormat: "string"},
+			errCode:     grpcCodes.InvalidArgument,
+			errContains: ptr("selector"),
+		},
+		{label: "selector with smallcaps passthrough",
+			data:        ptr(decodableSmallcaps),
+			request:     types.QueryCapDataRequest{Selector: "arr", ItemFormat: "smallcaps-json"},
+			errCode:     grpcCodes.InvalidArgument,
+			errContains: ptr("selector"),
+		},
+		{label: "smallcaps passthrough with remotable format",
+			data:        ptr(cell),
+			request:     types.QueryCapDataRequest{ItemFormat: "smallcaps-json", RemotableValueFormat: "slot"},
+			errCode:     grpcCodes.InvalidArgument,
+			errContains: ptr("remotable_value_format"),
+		},
+		{label: "smallcaps passthrough",
+			data:    ptr(mustMarshalStreamCell("1", []string{decodableSmallcaps, `{"slots":["a"],"body":"#\"$0.Alleged: Foo brand\""}`})),
+			request: types.QueryCapDataRequest{ItemFormat: "smallcaps-json"},
+			expected: types.QueryCapDataResponse{
+				BlockHeight: "1",
+				Value:       decodableSmallcaps + "\n" + `{"body":"#\"$0.Alleged: Foo brand\"","slots":["a"]}`,
+			},
+		},
+		{label: "smallcaps passthrough of legacy CapData",
+			data:        ptr(decodableLegacy),
+			request:     types.QueryCapDataRequest{ItemFormat: "smallcaps-json"},
+			errCode:     grpcCodes.FailedPrecondition,
+			errContains: ptr("smallcaps"),
+		},
+	}...)
+
+	// Test the representation of values that have no JSON equivalent.
+	expectDecoded := func(label, capdataBody string, slots []any, expected any) testCase {
 		if slots == nil {
 			slots = []any{}
 		}
@@ -244,30 +386,31 @@ func TestCapData(t *testing.T) {
 			"slots": slots,
 		})
 		return testCase{
-			label:       label,
-			data:        ptr(serialized),
-			request:     types.QueryCapDataRequest{RemotableValueFormat: "string"},
-			errCode:     grpcCodes.FailedPrecondition,
-			errContains: ptr("not implemented"),
+			label:    label,
+			data:     ptr(serialized),
+			request:  types.QueryCapDataRequest{RemotableValueFormat: "string"},
+			expected: types.QueryCapDataResponse{Value: mustJsonMarshal(expected)},
 		}
 	}
+	copySet := map[string]any{"tag": "copySet", "payload": []any{}}
+	fooError := map[string]any{"name": "Error", "message": "foo"}
 	testCases = append(testCases, []testCase{
-		expectNotImplemented("smallcaps undefined", `#"#undefined"`, nil),
-		expectNotImplemented("smallcaps NaN", `#"#NaN"`, nil),
-		expectNotImplemented("smallcaps infinity", `#"#Infinity"`, nil),
-		expectNotImplemented("smallcaps negative infinity", `#"#-Infinity"`, nil),
-		expectNotImplemented("smallcaps symbol", `#"%foo"`, nil),
-		expectNotImplemented("smallcaps promise", `#"&0"`, []any{"a"}),
-		expectNotImplemented("smallcaps tagged", `#{"#tag":"copySet","payload":[]}`, nil),
-		expectNotImplemented("smallcaps error", `#{"#error":"foo","name":"Error"}`, nil),
-		expectNotImplemented("legacy undefined", `{"@qclass":"undefined"}`, nil),
-		expectNotImplemented("legacy NaN", `{"@qclass":"NaN"}`, nil),
-		expectNotImplemented("legacy infinity", `{"@qclass":"Infinity"}`, nil),
-		expectNotImplemented("legacy negative infinity", `{"@qclass":"-Infinity"}`, nil),
-		expectNotImplemented("legacy symbol", `{"@qclass":"symbol","name":"foo"}`, nil),
-		expectNotImplemented("smallcaps tagged", `{"@qclass":"tagged","tag":"copySet","payload":[]}`, nil),
-		expectNotImplemented("smallcaps error", `{"@qclass":"error","message":"foo","name":"Error"}`, nil),
-		expectNotImplemented("smallcaps Hilbert Hotel", `{"@qclass":"hilbert","original":"foo"}`, nil),
+		expectDecoded("smallcaps undefined", `#"#undefined"`, nil, nil),
+		expectDecoded("smallcaps NaN", `#"#NaN"`, nil, "NaN"),
+		expectDecoded("smallcaps infinity", `#"#Infinity"`, nil, "Infinity"),
+		expectDecoded("smallcaps negative infinity", `#"#-Infinity"`, nil, "-Infinity"),
+		expectDecoded("smallcaps symbol", `#"%foo"`, nil, "Symbol(foo)"),
+		expectDecoded("smallcaps promise", `#"&0"`, []any{"a"}, "[Promise <a>]"),
+		expectDecoded("smallcaps tagged", `#{"#tag":"copySet","payload":[]}`, nil, copySet),
+		expectDecoded("smallcaps error", `#{"#error":"foo","name":"Error"}`, nil, fooError),
+		expectDecoded("legacy undefined", `{"@qclass":"undefined"}`, nil, nil),
+		expectDecoded("legacy NaN", `{"@qclass":"NaN"}`, nil, "NaN"),
+		expectDecoded("legacy infinity", `{"@qclass":"Infinity"}`, nil, "Infinity"),
+		expectDecoded("legacy negative infinity", `{"@qclass":"-Infinity"}`, nil, "-Infinity"),
+		expectDecoded("legacy symbol", `{"@qclass":"symbol","name":"foo"}`, nil, "Symbol(foo)"),
+		expectDecoded("legacy tagged", `{"@qclass":"tagged","tag":"copySet","payload":[]}`, nil, copySet),
+		expectDecoded("legacy error", `{"@qclass":"error","message":"foo","name":"Error"}`, nil, fooError),
+		expectDecoded("legacy Hilbert Hotel", `{"@qclass":"hilbert","original":"foo"}`, nil, map[string]any{"@qclass": "foo"}),
 	}...)
 	for _, desc := range testCases {
 		desc.request.Path = "key"
@@ -298,3 +441,428 @@ func TestCapData(t *testing.T) {
 		}
 	}
 }
+
+func TestChildQueries(t *testing.T) {
+	testKit := makeTestKit()
+	ctx, keeper := testKit.ctx, testKit.vstorageKeeper
+	querier := Querier{keeper}
+	wctx := sdk.WrapSDKContext(ctx)
+
+	keeper.SetStorage(ctx, agoric.NewKVEntry("parent.a", "alpha"))
+	keeper.SetStorage(ctx, agoric.NewKVEntry("parent.b.deep", "hidden"))
+	keeper.SetStorage(ctx, agoric.NewKVEntry("parent.c", ""))
+	keeper.SetStorage(ctx, agoric.NewKVEntry("parent.d", "delta"))
+	keeper.SetStorage(ctx, agoric.NewKVEntry("parentheses", "unrelated"))
+
+	allEntries := []types.ChildEntry{
+		{Child: "a", HasValue: true, Value: "alpha"},
+		{Child: "b", HasValue: false, Value: "", HasChildren: true},
+		{Child: "c", HasValue: true, Value: ""},
+		{Child: "d", HasValue: true, Value: "delta"},
+	}
+	allValues := []string{"alpha", "", "", "delta"}
+
+	entriesRes, err := querier.Entries(wctx, &types.QueryEntriesRequest{Path: "parent"})
+	if err != nil {
+		t.Fatalf("Entries: got unexpected error %v", err)
+	}
+	if !reflect.DeepEqual(entriesRes.Entries, allEntries) {
+		t.Errorf("Entries: got %#v, want %#v", entriesRes.Entries, allEntries)
+	}
+
+	valuesRes, err := querier.Values(wctx, &types.QueryValuesRequest{Path: "parent"})
+	if err != nil {
+		t.Fatalf("Values: got unexpected error %v", err)
+	}
+	if !reflect.DeepEqual(valuesRes.Values, allValues) {
+		t.Errorf("Values: got %q, want %q", valuesRes.Values, allValues)
+	}
+
+	sizeRes, err := querier.Size(wctx, &types.QuerySizeRequest{Path: "parent"})
+	if err != nil {
+		t.Fatalf("Size: got unexpected error %v", err)
+	}
+	if sizeRes.Size_ != 4 {
+		t.Errorf("Size: got %d, want 4", sizeRes.Size_)
+	}
+
+	// Page through entries two at a time using the returned next keys.
+	var paged []types.ChildEntry
+	var nextKey []byte
+	for page := 0; page < 3; page++ {
+		res, err := querier.Entries(wctx, &types.QueryEntriesRequest{
+			Path:       "parent",
+			Pagination: &query.PageRequest{Key: nextKey, Limit: 2, CountTotal: page == 0},
+		})
+		if err != nil {
+			t.Fatalf("Entries page %d: got unexpected error %v", page, err)
+		}
+		if page == 0 && res.Pagination.Total != 4 {
+			t.Errorf("Entries page %d: got total %d, want 4", page, res.Pagination.Total)
+		}
+		paged = append(paged, res.Entries...)
+		nextKey = res.Pagination.NextKey
+		if nextKey == nil {
+			break
+		}
+	}
+	if !reflect.DeepEqual(paged, allEntries) {
+		t.Errorf("paged Entries: got %#v, want %#v", paged, allEntries)
+	}
+
+	offsetRes, err := querier.Values(wctx, &types.QueryValuesRequest{
+		Path:       "parent",
+		Pagination: &query.PageRequest{Offset: 3, Limit: 10},
+	})
+	if err != nil {
+		t.Fatalf("Values with offset: got unexpected error %v", err)
+	}
+	if !reflect.DeepEqual(offsetRes.Values, allValues[3:]) {
+		t.Errorf("Values with offset: got %q, want %q", offsetRes.Values, allValues[3:])
+	}
+
+	// A path with no children has no entries.
+	emptyRes, err := querier.Entries(wctx, &types.QueryEntriesRequest{Path: "parent.a"})
+	if err != nil {
+		t.Fatalf("Entries of leaf: got unexpected error %v", err)
+	}
+	if len(emptyRes.Entries) != 0 {
+		t.Errorf("Entries of leaf: got %#v, want none", emptyRes.Entries)
+	}
+
+	// Invalid paths are rejected.
+	_, err = querier.Size(wctx, &types.QuerySizeRequest{Path: "parent."})
+	if code := grpcStatus.Code(err); code != grpcCodes.InvalidArgument {
+		t.Errorf("Size of invalid path: got error %v, want code %q", err, grpcCodes.InvalidArgument)
+	}
+}
+
+func TestStreamCellHistory(t *testing.T) {
+	testKit := makeTestKit()
+	ctx, keeper := testKit.ctx, testKit.vstorageKeeper
+
+	// Without retained history, the query is unavailable.
+	_, err := Querier{keeper}.StreamCellHistory(sdk.WrapSDKContext(ctx), &types.QueryStreamCellHistoryRequest{Path: "published.prices"})
+	if code := grpcStatus.Code(err); code != grpcCodes.Unimplemented {
+		t.Fatalf("without history: got error %v, want code %q", err, grpcCodes.Unimplemented)
+	}
+
+	historyDB := dbm.NewMemDB()
+	keeper.SetStreamCellHistory(NewStreamCellHistory(historyDB, []string{"published.prices"}))
+	querier := Querier{keeper}
+
+	// Write a cell in each of several blocks, plus one at an unretained path.
+	for height := int64(1); height <= 5; height++ {
+		blockCtx := ctx.WithBlockHeight(height)
+		keeper.NewChangeBatch(blockCtx)
+		for i := int64(0); i < height%2+1; i++ {
+			value := fmt.Sprintf("%d-%d", height, i)
+			if err := keeper.AppendStorageValueAndNotify(blockCtx, "published.prices.ATOM", value); err != nil {
+				t.Fatalf("append at height %d: %v", height, err)
+			}
+		}
+		if err := keeper.AppendStorageValueAndNotify(blockCtx, "published.other", "x"); err != nil {
+			t.Fatalf("append at height %d: %v", height, err)
+		}
+		keeper.FlushChangeEvents(blockCtx)
+	}
+
+	type testCase struct {
+		label    string
+		request  types.QueryStreamCellHistoryRequest
+		expected []types.StreamCell
+		errCode  grpcCodes.Code
+	}
+	testCases := []testCase{
+		{label: "all",
+			request: types.QueryStreamCellHistoryRequest{Path: "published.prices.ATOM"},
+			expected: []types.StreamCell{
+				{BlockHeight: "1", Values: []string{"1-0", "1-1"}},
+				{BlockHeight: "2", Values: []string{"2-0"}},
+				{BlockHeight: "3", Values: []string{"3-0", "3-1"}},
+				{BlockHeight: "4", Values: []string{"4-0"}},
+				{BlockHeight: "5", Values: []string{"5-0", "5-1"}},
+			},
+		},
+		{label: "range",
+			request: types.QueryStreamCellHistoryRequest{Path: "published.prices.ATOM", MinHeight: 2, MaxHeight: 3},
+			expected: []types.StreamCell{
+				{BlockHeight: "2", Values: []string{"2-0"}},
+				{BlockHeight: "3", Values: []string{"3-0", "3-1"}},
+			},
+		},
+		{label: "reversed page",
+			request: types.QueryStreamCellHistoryRequest{
+				Path:       "published.prices.ATOM",
+				MinHeight:  2,
+				Pagination: &query.PageRequest{Limit: 2, Reverse: true},
+			},
+			expected: []types.StreamCell{
+				{BlockHeight: "5", Values: []string{"5-0", "5-1"}},
+				{BlockHeight: "4", Values: []string{"4-0"}},
+			},
+		},
+		{label: "no cells",
+			request:  types.QueryStreamCellHistoryRequest{Path: "published.prices.BLD"},
+			expected: []types.StreamCell{},
+		},
+		{label: "unretained path",
+			request: types.QueryStreamCellHistoryRequest{Path: "published.other"},
+			errCode: grpcCodes.Unimplemented,
+		},
+		{label: "inverted range",
+			request: types.QueryStreamCellHistoryRequest{Path: "published.prices.ATOM", MinHeight: 3, MaxHeight: 2},
+			errCode: grpcCodes.InvalidArgument,
+		},
+	}
+	for _, desc := range testCases {
+		resp, err := querier.StreamCellHistory(sdk.WrapSDKContext(ctx), &desc.request)
+		if desc.errCode != grpcCodes.OK {
+			if code := grpcStatus.Code(err); code != desc.errCode {
+				t.Errorf("%s: got error %v, want code %q", desc.label, err, desc.errCode)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: got unexpected error %v", desc.label, err)
+		} else if !reflect.DeepEqual(resp.Cells, desc.expected) {
+			t.Errorf("%s: got %#v, want %#v", desc.label, resp.Cells, desc.expected)
+		} else if resp.EarliestHeight != 1 {
+			t.Errorf("%s: got earliest height %d, want 1", desc.label, resp.EarliestHeight)
+		}
+	}
+
+	// After a restart, previously retained paths keep their earliest height and
+	// newly retained paths start from the next block, without backfill.
+	keeper.SetStreamCellHistory(NewStreamCellHistory(historyDB, []string{"published.prices", "published.other"}))
+	querier = Querier{keeper}
+	keeper.NewChangeBatch(ctx.WithBlockHeight(6))
+	keeper.FlushChangeEvents(ctx.WithBlockHeight(6))
+	for path, expected := range map[string]int64{"published.prices.ATOM": 1, "published.other": 6} {
+		resp, err := querier.StreamCellHistory(sdk.WrapSDKContext(ctx), &types.QueryStreamCellHistoryRequest{Path: path})
+		if err != nil {
+			t.Errorf("after restart %s: got unexpected error %v", path, err)
+		} else if resp.EarliestHeight != expected {
+			t.Errorf("after restart %s: got earliest height %d, want %d", path, resp.EarliestHeight, expected)
+		} else if path == "published.other" && len(resp.Cells) != 0 {
+			t.Errorf("after restart %s: got backfilled cells %#v", path, resp.Cells)
+		}
+	}
+}
+
+func TestUsage(t *testing.T) {
+	testKit := makeTestKit()
+	ctx, keeper := testKit.ctx, testKit.vstorageKeeper
+	querier := Querier{keeper}
+
+	keeper.SetStorage(ctx, agoric.NewKVEntry("", "root"))
+	keeper.SetStorage(ctx, agoric.NewKVEntry("a", "1"))
+	keeper.SetStorage(ctx, agoric.NewKVEntry("a.b.c", "22"))
+	keeper.SetStorage(ctx, agoric.NewKVEntry("a.b.d", "333"))
+	keeper.SetStorage(ctx, agoric.NewKVEntry("a.b.d", "4444"))
+	keeper.SetStorage(ctx, agoric.NewKVEntry("x.y", ""))
+	keeper.SetStorage(ctx, agoric.NewKVEntry("z", "gone"))
+	keeper.SetStorage(ctx, agoric.NewKVEntryWithNoValue("z"))
+
+	expected := []types.SubtreeUsage{
+		{Path: "a", Bytes: 1 + 1 + 5 + 2 + 5 + 4},
+		{Path: "x", Bytes: 3},
+	}
+	res, err := querier.Usage(sdk.WrapSDKContext(ctx), &types.QueryUsageRequest{})
+	if err != nil {
+		t.Fatalf("Usage: got error %v", err)
+	}
+	if !reflect.DeepEqual(res.Usage, expected) {
+		t.Errorf("Usage: got %+v, want %+v", res.Usage, expected)
+	}
+
+	// Recomputing from the entries gives the same usage.
+	keeper.RecomputeSubtreeUsage(ctx)
+	res, err = querier.Usage(sdk.WrapSDKContext(ctx), &types.QueryUsageRequest{})
+	if err != nil {
+		t.Fatalf("Usage after recompute: got error %v", err)
+	}
+	if !reflect.DeepEqual(res.Usage, expected) {
+		t.Errorf("Usage after recompute: got %+v, want %+v", res.Usage, expected)
+	}
+
+	keeper.RemoveEntriesWithPrefix(ctx, "a.b")
+	if got, want := keeper.GetSubtreeBytes(ctx, "a"), uint64(2); got != want {
+		t.Errorf("after removing a.b: got %d bytes, want %d", got, want)
+	}
+	keeper.RemoveEntriesWithPrefix(ctx, "x")
+	res, err = querier.Usage(sdk.WrapSDKContext(ctx), &types.QueryUsageRequest{})
+	if err != nil {
+		t.Fatalf("Usage after removal: got error %v", err)
+	}
+	expected = []types.SubtreeUsage{{Path: "a", Bytes: 2}}
+	if !reflect.DeepEqual(res.Usage, expected) {
+		t.Errorf("Usage after removal: got %+v, want %+v", res.Usage, expected)
+	}
+}
+
+func TestChildCounts(t *testing.T) {
+	testKit := makeTestKit()
+	ctx, keeper := testKit.ctx, testKit.vstorageKeeper
+
+	checkCounts := func(label string, expected map[string]uint64) {
+		t.Helper()
+		for path, want := range expected {
+			if got := keeper.CountChildren(ctx, path); got != want {
+				t.Errorf("%s: got %d children of %q, want %d", label, got, path, want)
+			}
+			if got := uint64(len(keeper.GetChildren(ctx, path).Children)); got != want {
+				t.Errorf("%s: got %d listed children of %q, want %d", label, got, path, want)
+			}
+		}
+	}
+
+	keeper.SetStorage(ctx, agoric.NewKVEntry("a", "1"))
+	keeper.SetStorage(ctx, agoric.NewKVEntry("a.b.c", "2"))
+	keeper.SetStorage(ctx, agoric.NewKVEntry("a.b.d", "3"))
+	keeper.SetStorage(ctx, agoric.NewKVEntry("a.b.d", "4"))
+	keeper.SetStorage(ctx, agoric.NewKVEntry("a.e", "5"))
+	keeper.SetStorage(ctx, agoric.NewKVEntry("x.y", "6"))
+	checkCounts("after set", map[string]uint64{"": 2, "a": 2, "a.b": 2, "a.b.c": 0, "x": 1})
+
+	keeper.SetStorage(ctx, agoric.NewKVEntryWithNoValue("a.b.c"))
+	keeper.SetStorage(ctx, agoric.NewKVEntryWithNoValue("x.y"))
+	checkCounts("after delete", map[string]uint64{"": 1, "a": 2, "a.b": 1, "x": 0})
+
+	// Recomputing from the entries gives the same counts.
+	keeper.RecomputeChildCounts(ctx)
+	checkCounts("after recompute", map[string]uint64{"": 1, "a": 2, "a.b": 1, "x": 0})
+
+	keeper.RemoveEntriesWithPrefix(ctx, "a.b")
+	checkCounts("after removing a.b", map[string]uint64{"": 1, "a": 1, "a.b": 0})
+	keeper.RemoveEntriesWithPrefix(ctx, "a")
+	checkCounts("after removing a", map[string]uint64{"": 0, "a": 0})
+}
+
+// watchStream collects the responses of a Watch query, canceling it once it
+// has received a given number of them.
+type watchStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	cancel    context.CancelFunc
+	limit     int
+	responses []types.QueryWatchResponse
+}
+
+func newWatchStream(limit int) *watchStream {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &watchStream{ctx: ctx, cancel: cancel, limit: limit}
+}
+
+func (s *watchStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *watchStream) Send(resp *types.QueryWatchResponse) error {
+	s.responses = append(s.responses, *resp)
+	if len(s.responses) >= s.limit {
+		s.cancel()
+	}
+	return nil
+}
+
+func TestWatch(t *testing.T) {
+	testKit := makeTestKit()
+	ctx, keeper := testKit.ctx, testKit.vstorageKeeper
+
+	// Without a change feed, the query is unavailable.
+	err := Querier{keeper}.Watch(&types.QueryWatchRequest{Path: "a"}, newWatchStream(1))
+	if code := grpcStatus.Code(err); code != grpcCodes.Unimplemented {
+		t.Fatalf("without feed: got error %v, want code %q", err, grpcCodes.Unimplemented)
+	}
+
+	feed := NewChangeFeed(3)
+	keeper.SetChangeFeed(feed)
+	querier := Querier{keeper}
+	executeBlock := func(height int64, entries ...agoric.KVEntry) {
+		blockCtx := ctx.WithBlockHeight(height)
+		keeper.NewChangeBatch(blockCtx)
+		for _, entry := range entries {
+			keeper.SetStorageAndNotify(blockCtx, entry)
+		}
+		keeper.FlushChangeEvents(blockCtx)
+		feed.Commit(height)
+	}
+	for height := int64(1); height <= 4; height++ {
+		executeBlock(height,
+			agoric.NewKVEntry("a.c", fmt.Sprintf("c%d", height)),
+			agoric.NewKVEntry("a", fmt.Sprintf("a%d", height)),
+			agoric.NewKVEntry("x", fmt.Sprintf("x%d", height)),
+		)
+	}
+	executeBlock(5, agoric.NewKVEntryWithNoValue("a.c"))
+
+	type testCase struct {
+		label    string
+		request  types.QueryWatchRequest
+		expected []types.QueryWatchResponse
+		errCode  grpcCodes.Code
+	}
+	testCases := []testCase{
+		{label: "resumed",
+			request: types.QueryWatchRequest{Path: "a", FromHeight: 4},
+			expected: []types.QueryWatchResponse{
+				{BlockHeight: 4, Path: "a", Value: "a4"},
+			},
+		},
+		{label: "resumed recursive",
+			request: types.QueryWatchRequest{Path: "a", Recursive: true, FromHeight: 3},
+			expected: []types.QueryWatchResponse{
+				{BlockHeight: 3, Path: "a", Value: "a3"},
+				{BlockHeight: 3, Path: "a.c", Value: "c3"},
+				{BlockHeight: 4, Path: "a", Value: "a4"},
+				{BlockHeight: 4, Path: "a.c", Value: "c4"},
+				{BlockHeight: 5, Path: "a.c", Value: ""},
+			},
+		},
+		{label: "no longer retained",
+			request: types.QueryWatchRequest{Path: "a", FromHeight: 2},
+			errCode: grpcCodes.OutOfRange,
+		},
+		{label: "invalid path",
+			request: types.QueryWatchRequest{Path: "a..b"},
+			errCode: grpcCodes.InvalidArgument,
+		},
+	}
+	for _, desc := range testCases {
+		stream := newWatchStream(len(desc.expected))
+		err := querier.Watch(&desc.request, stream)
+		if desc.errCode != grpcCodes.OK {
+			if code := grpcStatus.Code(err); code != desc.errCode {
+				t.Errorf("%s: got error %v, want code %q", desc.label, err, desc.errCode)
+			}
+			continue
+		}
+		if code := grpcStatus.Code(err); code != grpcCodes.Canceled {
+			t.Errorf("%s: got error %v, want code %q", desc.label, err, grpcCodes.Canceled)
+		}
+		if !reflect.DeepEqual(stream.responses, desc.expected) {
+			t.Errorf("%s: got %+v, want %+v", desc.label, stream.responses, desc.expected)
+		}
+	}
+
+	// A watch of future blocks waits for them to be committed.
+	stream := newWatchStream(2)
+	done := make(chan error)
+	go func() {
+		done <- querier.Watch(&types.QueryWatchRequest{Path: "x", FromHeight: 6}, stream)
+	}()
+	executeBlock(6, agoric.NewKVEntry("x", "x6"))
+	executeBlock(7, agoric.NewKVEntry("a", "a7"))
+	executeBlock(8, agoric.NewKVEntry("x", "x8"))
+	if err := <-done; grpcStatus.Code(err) != grpcCodes.Canceled {
+		t.Errorf("live: got error %v, want code %q", err, grpcCodes.Canceled)
+	}
+	expected := []types.QueryWatchResponse{
+		{BlockHeight: 6, Path: "x", Value: "x6"},
+		{BlockHeight: 8, Path: "x", Value: "x8"},
+	}
+	if !reflect.DeepEqual(stream.responses, expected) {
+		t.Errorf("live: got %+v, want %+v", stream.responses, expected)
+	}
+}
@@ -7,9 +7,13 @@ import (
 	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/types"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/store"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -19,6 +23,9 @@ import (
 
 var (
 	vstorageStoreKey = storetypes.NewKVStoreKey(types.StoreKey)
+	metaStoreKey     = storetypes.NewKVStoreKey(types.MetaStoreKey)
+	paramsStoreKey   = storetypes.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTStoreKey  = storetypes.NewTransientStoreKey(paramstypes.TStoreKey)
 )
 
 type testKit struct {
@@ -27,16 +34,21 @@ type testKit struct {
 }
 
 func makeTestKit() testKit {
-	keeper := NewKeeper(vstorageStoreKey)
+	pk := paramskeeper.NewKeeper(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), codec.NewLegacyAmino(), paramsStoreKey, paramsTStoreKey)
+	keeper := NewKeeper(vstorageStoreKey, metaStoreKey, pk.Subspace(types.ModuleName))
 
 	db := dbm.NewMemDB()
 	ms := store.NewCommitMultiStore(db)
 	ms.MountStoreWithDB(vstorageStoreKey, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(metaStoreKey, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsStoreKey, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsTStoreKey, storetypes.StoreTypeTransient, db)
 	err := ms.LoadLatestVersion()
 	if err != nil {
 		panic(err)
 	}
 	ctx := sdk.NewContext(ms, tmproto.Header{}, false, log.NewNopLogger())
+	keeper.SetParams(ctx, types.DefaultParams())
 
 	return testKit{ctx, keeper}
 }
@@ -206,6 +218,40 @@ func TestStorage(t *testing.T) {
 	}
 }
 
+func TestDescendants(t *testing.T) {
+	testKit := makeTestKit()
+	ctx, keeper := testKit.ctx, testKit.vstorageKeeper
+
+	// Build a subtree deeper than 9 levels (where decimal depth prefixes stop
+	// sorting numerically) alongside siblings sharing a textual prefix.
+	deepPath := "a.b.c.d.e.f.g.h.i.j.k.l"
+	for _, path := range []string{deepPath, "a.b.x", "a.by", "a-b.c", "ab", "z"} {
+		keeper.SetStorage(ctx, agoric.NewKVEntry(path, "v:"+path))
+	}
+
+	expectedExport := []*types.DataEntry{
+		{Path: "x", Value: "v:a.b.x"},
+		{Path: "c.d.e.f.g.h.i.j.k.l", Value: "v:" + deepPath},
+	}
+	if got := keeper.ExportStorageFromPrefix(ctx, "a.b"); !reflect.DeepEqual(got, expectedExport) {
+		t.Errorf("got export %q, want %q", got, expectedExport)
+	}
+
+	keeper.RemoveEntriesWithPrefix(ctx, "a.b")
+	if keeper.HasEntry(ctx, "a.b.c") || keeper.HasEntry(ctx, "a.b") {
+		t.Errorf("got leftover entries for a.b after removal")
+	}
+	expectedRemaining := []*types.DataEntry{
+		{Path: "ab", Value: "v:ab"},
+		{Path: "z", Value: "v:z"},
+		{Path: "a.by", Value: "v:a.by"},
+		{Path: "a-b.c", Value: "v:a-b.c"},
+	}
+	if got := keeper.ExportStorage(ctx); !reflect.DeepEqual(got, expectedRemaining) {
+		t.Errorf("got remaining export %q, want %q", got, expectedRemaining)
+	}
+}
+
 func TestStorageNotify(t *testing.T) {
 	tk := makeTestKit()
 	ctx, keeper := tk.ctx, tk.vstorageKeeper
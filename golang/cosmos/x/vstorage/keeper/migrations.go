@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator handles in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new migrator based on the keeper.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2, introducing params (which
+// default to no limits) and the accounting of usage under each top-level path
+// and of the children of each path.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.SetParams(ctx, types.DefaultParams())
+	m.keeper.RecomputeSubtreeUsage(ctx)
+	m.keeper.RecomputeChildCounts(ctx)
+	return nil
+}
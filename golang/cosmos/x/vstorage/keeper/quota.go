@@ -0,0 +1,223 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/types"
+)
+
+// GetParams returns the vstorage module parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the vstorage module parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// entryBytes returns the number of bytes that an entry counts against the
+// usage of its subtree: those of its path and data, or zero if it has no data.
+func entryBytes(entry agoric.KVEntry) uint64 {
+	if !entry.HasValue() {
+		return 0
+	}
+	return uint64(len(entry.Key()) + len(entry.StringValue()))
+}
+
+// GetSubtreeBytes returns the total bytes used by the entries with data under
+// a top-level path.
+func (k Keeper) GetSubtreeBytes(ctx sdk.Context, topLevel string) uint64 {
+	metaStore := ctx.KVStore(k.metaStoreKey)
+	bz := metaStore.Get(types.TopLevelPathToSubtreeBytesKey(topLevel))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setSubtreeBytes(ctx sdk.Context, topLevel string, used uint64) {
+	metaStore := ctx.KVStore(k.metaStoreKey)
+	key := types.TopLevelPathToSubtreeBytesKey(topLevel)
+	if used == 0 {
+		metaStore.Delete(key)
+		return
+	}
+	metaStore.Set(key, sdk.Uint64ToBigEndian(used))
+}
+
+// updateSubtreeBytes adjusts the usage of the subtree containing path for its
+// data changing from oldBytes to newBytes.  The empty path is not in any
+// subtree.  Usage that is inconsistent with the data, which CheckStorageQuota
+// reports as an error, is not allowed to go below zero.
+func (k Keeper) updateSubtreeBytes(ctx sdk.Context, path string, oldBytes, newBytes uint64) {
+	topLevel := types.PathToTopLevelPath(path)
+	if topLevel == "" || oldBytes == newBytes {
+		return
+	}
+	used := k.GetSubtreeBytes(ctx, topLevel)
+	if oldBytes > used {
+		ctx.Logger().Error("inconsistent vstorage subtree usage", "subtree", topLevel, "used", used, "path", path, "bytes", oldBytes)
+		used = oldBytes
+	}
+	k.setSubtreeBytes(ctx, topLevel, used-oldBytes+newBytes)
+}
+
+// getChildCount returns the number of children of the path with an encoded
+// key.
+func (k Keeper) getChildCount(ctx sdk.Context, encodedKey []byte) uint64 {
+	metaStore := ctx.KVStore(k.metaStoreKey)
+	bz := metaStore.Get(types.EncodedKeyToChildCountKey(encodedKey))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setChildCount(ctx sdk.Context, encodedKey []byte, count uint64) {
+	metaStore := ctx.KVStore(k.metaStoreKey)
+	key := types.EncodedKeyToChildCountKey(encodedKey)
+	if count == 0 {
+		metaStore.Delete(key)
+		return
+	}
+	metaStore.Set(key, sdk.Uint64ToBigEndian(count))
+}
+
+// deleteChildCount forgets the number of children of a removed path.
+func (k Keeper) deleteChildCount(ctx sdk.Context, encodedKey []byte) {
+	k.setChildCount(ctx, encodedKey, 0)
+}
+
+// addedPath counts a new store entry for a path as a child of its parent.
+func (k Keeper) addedPath(ctx sdk.Context, path string) {
+	if path == "" {
+		return
+	}
+	parentKey := types.PathToEncodedKey(types.PathToParentPath(path))
+	k.setChildCount(ctx, parentKey, k.getChildCount(ctx, parentKey)+1)
+}
+
+// removedPath stops counting a removed store entry for a path as a child of
+// its parent.
+func (k Keeper) removedPath(ctx sdk.Context, path string) {
+	k.deleteChildCount(ctx, types.PathToEncodedKey(path))
+	if path == "" {
+		return
+	}
+	parentKey := types.PathToEncodedKey(types.PathToParentPath(path))
+	count := k.getChildCount(ctx, parentKey)
+	if count == 0 {
+		ctx.Logger().Error("inconsistent vstorage child count", "path", types.PathToParentPath(path), "child", path)
+		return
+	}
+	k.setChildCount(ctx, parentKey, count-1)
+}
+
+// PaginateSubtreeUsage calls onResult with the usage of each top-level path
+// that has data, in path order, subject to pageRequest.
+func (k Keeper) PaginateSubtreeUsage(
+	ctx sdk.Context,
+	pageRequest *query.PageRequest,
+	onResult func(usage types.SubtreeUsage) error,
+) (*query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.metaStoreKey), types.SubtreeBytesKeyPrefix)
+	return query.Paginate(store, pageRequest, func(key []byte, value []byte) error {
+		return onResult(types.SubtreeUsage{Path: string(key), Bytes: sdk.BigEndianToUint64(value)})
+	})
+}
+
+// RecomputeSubtreeUsage replaces the usage of every top-level path with the
+// total computed from its entries.
+func (k Keeper) RecomputeSubtreeUsage(ctx sdk.Context) {
+	deleteAllWithPrefix(ctx.KVStore(k.metaStoreKey), types.SubtreeBytesKeyPrefix)
+
+	for _, topLevel := range k.GetChildren(ctx, "").Children {
+		used := entryBytes(k.GetEntry(ctx, topLevel))
+		for _, entry := range k.ExportStorageFromPrefix(ctx, topLevel) {
+			used += entryBytes(agoric.NewKVEntry(topLevel+types.PathSeparator+entry.Path, entry.Value))
+		}
+		k.setSubtreeBytes(ctx, topLevel, used)
+	}
+}
+
+// RecomputeChildCounts replaces the number of children of every path with
+// the count of its entries.
+func (k Keeper) RecomputeChildCounts(ctx sdk.Context) {
+	deleteAllWithPrefix(ctx.KVStore(k.metaStoreKey), types.ChildCountKeyPrefix)
+
+	iterator := k.newDescendantIterator(ctx, "")
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		k.addedPath(ctx, types.EncodedKeyToPath(iterator.Key()))
+	}
+}
+
+// deleteAllWithPrefix deletes every entry of a store whose key has a prefix.
+func deleteAllWithPrefix(store sdk.KVStore, keyPrefix []byte) {
+	iterator := sdk.KVStorePrefixIterator(store, keyPrefix)
+	keys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// CheckStorageQuota returns an error if setting an entry would exceed any of
+// the limits in the module parameters.  Removing data is always allowed, as is
+// a write that does not increase the usage of an already oversized subtree.
+func (k Keeper) CheckStorageQuota(ctx sdk.Context, entry agoric.KVEntry) error {
+	if !entry.HasValue() {
+		return nil
+	}
+	params := k.GetParams(ctx)
+	path := entry.Key()
+
+	if params.MaxValueBytes > 0 && uint64(len(entry.StringValue())) > params.MaxValueBytes {
+		return fmt.Errorf("%w: %d bytes at %q exceeds limit of %d",
+			types.ErrValueTooLarge, len(entry.StringValue()), path, params.MaxValueBytes)
+	}
+
+	if params.MaxChildren > 0 && !k.HasEntry(ctx, path) {
+		// Only the deepest existing ancestor gains a child; any missing
+		// ancestors between it and path will have just one.
+		pathComponents := strings.Split(path, types.PathSeparator)
+		for i := len(pathComponents) - 1; i >= 0; i-- {
+			ancestor := componentsToPath(pathComponents[0:i])
+			if !k.HasEntry(ctx, ancestor) {
+				continue
+			}
+			if count := k.CountChildren(ctx, ancestor); count >= params.MaxChildren {
+				return fmt.Errorf("%w: %q already has %d children, the limit",
+					types.ErrTooManyChildren, ancestor, count)
+			}
+			break
+		}
+	}
+
+	topLevel := types.PathToTopLevelPath(path)
+	if params.MaxSubtreeBytes > 0 && topLevel != "" {
+		oldBytes := entryBytes(k.GetEntry(ctx, path))
+		newBytes := entryBytes(entry)
+		used := k.GetSubtreeBytes(ctx, topLevel)
+		if oldBytes > used {
+			return fmt.Errorf("vstorage subtree %q usage %d is less than the %d bytes at %q", topLevel, used, oldBytes, path)
+		}
+		used = used - oldBytes + newBytes
+		if newBytes > oldBytes && used > params.MaxSubtreeBytes {
+			return fmt.Errorf("%w: writing %q would use %d bytes under %q, exceeding limit of %d",
+				types.ErrSubtreeTooLarge, path, used, topLevel, params.MaxSubtreeBytes)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/capdata"
+)
+
+// selectorStep is one step of a parsed CapData selector, which selects either
+// a named member of an object, an indexed element of an array, or (when
+// wildcard is true) every member or element.
+type selectorStep struct {
+	name     string
+	index    int
+	isIndex  bool
+	wildcard bool
+}
+
+// capDataSelector is a parsed JSONPath-style expression for projecting
+// decoded CapData, as described for QueryCapDataRequest.selector.
+type capDataSelector struct {
+	steps    []selectorStep
+	wildcard bool
+}
+
+// parseCapDataSelector parses a selector expression such as
+// `$.quoteAmount.value.amountOut.value`, `quotes[0]["amount"]`, or `$.*.id`.
+func parseCapDataSelector(expr string) (*capDataSelector, error) {
+	selector := &capDataSelector{}
+	rest, hasRoot := strings.CutPrefix(expr, "$")
+	// Without "$", the first member name needs no leading dot.
+	if !hasRoot && rest != "" && rest[0] != '[' {
+		rest = "." + rest
+	}
+	for rest != "" {
+		var step selectorStep
+		switch rest[0] {
+		case '.':
+			end := strings.IndexAny(rest[1:], ".[") + 1
+			if end == 0 {
+				end = len(rest)
+			}
+			step.name = rest[1:end]
+			rest = rest[end:]
+			if step.name == "" {
+				return nil, fmt.Errorf("empty member name in selector %q", expr)
+			}
+			step.wildcard = step.name == "*"
+		case '[':
+			var subscript string
+			if strings.HasPrefix(rest, `["`) {
+				// Find the end of the quoted name, skipping escaped characters.
+				end := 2
+				for ; end < len(rest) && rest[end] != '"'; end++ {
+					if rest[end] == '\\' {
+						end++
+					}
+				}
+				if end >= len(rest)-1 || rest[end+1] != ']' {
+					return nil, fmt.Errorf("unterminated subscript in selector %q", expr)
+				}
+				if err := json.Unmarshal([]byte(rest[1:end+1]), &step.name); err != nil {
+					return nil, fmt.Errorf("invalid quoted name in selector %q: %w", expr, err)
+				}
+				rest = rest[end+2:]
+				break
+			}
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated subscript in selector %q", expr)
+			}
+			subscript, rest = rest[1:end], rest[end+1:]
+			if subscript == "*" {
+				step.wildcard = true
+				break
+			}
+			index, err := strconv.ParseUint(subscript, 10, 31)
+			if err != nil {
+				return nil, fmt.Errorf("invalid index %q in selector %q", subscript, expr)
+			}
+			step.index, step.isIndex = int(index), true
+		default:
+			return nil, fmt.Errorf("unexpected %q in selector %q", rest[0], expr)
+		}
+		selector.wildcard = selector.wildcard || step.wildcard
+		selector.steps = append(selector.steps, step)
+	}
+	return selector, nil
+}
+
+// unwrapRemotable returns the raw representation of a Remotable as produced by
+// its transformation, or any other input unchanged.
+func unwrapRemotable(input interface{}) (interface{}, error) {
+	remotable, ok := input.(*capdata.CapdataRemotable)
+	if !ok {
+		return input, nil
+	}
+	var replacement interface{}
+	repr, err := capdata.JsonMarshal(remotable)
+	if err == nil {
+		err = json.Unmarshal(repr, &replacement)
+	}
+	return replacement, err
+}
+
+// Select projects a decoded CapData item, returning the single value reached
+// by a selector without wildcards (or nil if there is none) or an array of
+// every value reached by a selector with wildcards.
+func (s *capDataSelector) Select(item interface{}) (interface{}, error) {
+	matches := []interface{}{item}
+	for _, step := range s.steps {
+		next := []interface{}{}
+		for _, match := range matches {
+			match, err := unwrapRemotable(match)
+			if err != nil {
+				return nil, err
+			}
+			switch container := match.(type) {
+			case []interface{}:
+				if step.wildcard {
+					next = append(next, container...)
+				} else if step.isIndex && step.index < len(container) {
+					next = append(next, container[step.index])
+				}
+			case map[string]interface{}:
+				if step.wildcard {
+					// Visit members in a deterministic order.
+					keys := make([]string, 0, len(container))
+					for key := range container {
+						keys = append(keys, key)
+					}
+					sort.Strings(keys)
+					for _, key := range keys {
+						next = append(next, container[key])
+					}
+				} else if value, ok := container[step.name]; ok && !step.isIndex {
+					next = append(next, value)
+				}
+			}
+		}
+		matches = next
+	}
+
+	if s.wildcard {
+		return matches, nil
+	}
+	if len(matches) == 0 {
+		return nil, nil
+	}
+	return matches[0], nil
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestCapDataSelector(t *testing.T) {
+	var item interface{}
+	itemJson := `{"quotes":[{"amount":{"value":"1"},"a.b":true},{"amount":{"value":"2"}}],"id":"x"}`
+	if err := json.Unmarshal([]byte(itemJson), &item); err != nil {
+		t.Fatal(err)
+	}
+
+	type testCase struct {
+		selector    string
+		expected    interface{}
+		errContains bool
+	}
+	testCases := []testCase{
+		{selector: "", expected: item},
+		{selector: "$", expected: item},
+		{selector: "id", expected: "x"},
+		{selector: "$.id", expected: "x"},
+		{selector: "quotes[1].amount.value", expected: "2"},
+		{selector: `$["quotes"][0]["a.b"]`, expected: true},
+		{selector: "quotes[2].amount", expected: nil},
+		{selector: "id.value", expected: nil},
+		{selector: "quotes.amount", expected: nil},
+		{selector: "quotes[*].amount.value", expected: []interface{}{"1", "2"}},
+		{selector: "$.*", expected: []interface{}{"x", item.(map[string]interface{})["quotes"]}},
+		{selector: "$.missing[*]", expected: []interface{}{}},
+		{selector: "quotes[", errContains: true},
+		{selector: "quotes[-1]", errContains: true},
+		{selector: "quotes..amount", errContains: true},
+		{selector: `quotes["amount]`, errContains: true},
+		{selector: "$quotes", errContains: true},
+	}
+	for _, desc := range testCases {
+		selector, err := parseCapDataSelector(desc.selector)
+		if desc.errContains {
+			if err == nil {
+				t.Errorf("%q: got no error", desc.selector)
+			}
+			continue
+		} else if err != nil {
+			t.Errorf("%q: got unexpected error %v", desc.selector, err)
+			continue
+		}
+		got, err := selector.Select(item)
+		if err != nil {
+			t.Errorf("%q: got unexpected select error %v", desc.selector, err)
+		} else if !reflect.DeepEqual(got, desc.expected) {
+			t.Errorf("%q: got %#v, want %#v", desc.selector, got, desc.expected)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/types"
+)
+
+// DefaultWatchRetainBlocks is the default number of committed blocks whose
+// changes a ChangeFeed retains for resuming watches.
+const DefaultWatchRetainBlocks = 100
+
+// WatchUpdate is a change to the data at a vstorage path in a block, with an
+// empty Value representing deletion.
+type WatchUpdate struct {
+	BlockHeight int64
+	Path        string
+	Value       string
+}
+
+// ChangeFeed relays the changes that EmitChange reports as state_change events
+// to watchers once their block has been committed, retaining the changes of
+// recent blocks so that an interrupted watch can resume where it left off.
+// Like StreamCellHistory, it is node-local and only covers blocks executed by
+// the node since it started.
+type ChangeFeed struct {
+	mu           sync.Mutex
+	retainBlocks int64
+	// staged holds the changes of the block being executed.
+	staged []WatchUpdate
+	// committed holds the changes of retained committed blocks, in order of
+	// block height and then path.
+	committed []WatchUpdate
+	// retainedHeight is the lowest block height whose changes are all in
+	// committed, or zero if no block has been committed.
+	retainedHeight  int64
+	committedHeight int64
+	// nextCommit is closed (and replaced) when a block is committed.
+	nextCommit chan struct{}
+}
+
+// NewChangeFeed returns a ChangeFeed that retains the changes of the most
+// recent retainBlocks committed blocks (at least one).
+func NewChangeFeed(retainBlocks int64) *ChangeFeed {
+	if retainBlocks < 1 {
+		retainBlocks = 1
+	}
+	return &ChangeFeed{retainBlocks: retainBlocks, nextCommit: make(chan struct{})}
+}
+
+// Stage adds a change to those of the block being executed, discarding any
+// changes left over from a block that was not committed.
+func (f *ChangeFeed) Stage(update WatchUpdate) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if len(f.staged) > 0 && f.staged[0].BlockHeight != update.BlockHeight {
+		f.staged = nil
+	}
+	f.staged = append(f.staged, update)
+}
+
+// Commit publishes the changes staged for a now-committed block to watchers.
+func (f *ChangeFeed) Commit(blockHeight int64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	staged := f.staged
+	f.staged = nil
+	if len(staged) > 0 && staged[0].BlockHeight == blockHeight {
+		f.committed = append(f.committed, staged...)
+	}
+	if f.retainedHeight == 0 {
+		f.retainedHeight = blockHeight
+	}
+	f.committedHeight = blockHeight
+
+	// Forget blocks that are no longer retained.
+	if minHeight := blockHeight - f.retainBlocks + 1; minHeight > f.retainedHeight {
+		f.retainedHeight = minHeight
+		i := sort.Search(len(f.committed), func(i int) bool { return f.committed[i].BlockHeight >= minHeight })
+		f.committed = append([]WatchUpdate(nil), f.committed[i:]...)
+	}
+
+	close(f.nextCommit)
+	f.nextCommit = make(chan struct{})
+}
+
+// Since returns the retained changes of committed blocks with height at least
+// fromHeight (or after the latest committed block if fromHeight is zero), the
+// height to request next, and a channel that is closed when another block is
+// committed.
+// It fails if the changes of any such block are no longer retained.
+func (f *ChangeFeed) Since(fromHeight int64) ([]WatchUpdate, int64, <-chan struct{}, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if fromHeight == 0 {
+		fromHeight = f.committedHeight + 1
+	}
+	if fromHeight <= f.committedHeight && fromHeight < f.retainedHeight {
+		return nil, 0, nil, fmt.Errorf("block height %d is no longer retained (lowest is %d)", fromHeight, f.retainedHeight)
+	}
+	i := sort.Search(len(f.committed), func(i int) bool { return f.committed[i].BlockHeight >= fromHeight })
+	updates := f.committed[i:len(f.committed):len(f.committed)]
+	next := fromHeight
+	if f.committedHeight >= next {
+		next = f.committedHeight + 1
+	}
+	return updates, next, f.nextCommit, nil
+}
+
+// WatchMatches tells if a watch of a path, optionally including its
+// descendants, covers the data at another path.
+func WatchMatches(watchedPath string, recursive bool, path string) bool {
+	if path == watchedPath {
+		return true
+	}
+	if !recursive {
+		return false
+	}
+	return watchedPath == "" || strings.HasPrefix(path, watchedPath+types.PathSeparator)
+}
+
+// SetChangeFeed enables relaying of changes to watchers.
+// It must be called before the Keeper is copied into any other component.
+func (k *Keeper) SetChangeFeed(feed *ChangeFeed) {
+	k.changeFeed = feed
+}
+
+// GetChangeFeed returns the feed of changes to watchers, or nil if relaying
+// is not enabled.
+func (k Keeper) GetChangeFeed() *ChangeFeed {
+	return k.changeFeed
+}
+
+// stageWatchUpdate adds a change to the feed for watchers if it is enabled.
+func (k Keeper) stageWatchUpdate(ctx sdk.Context, change *ProposedChange) {
+	if k.changeFeed == nil {
+		return
+	}
+	k.changeFeed.Stage(WatchUpdate{
+		BlockHeight: ctx.BlockHeight(),
+		Path:        change.Path,
+		Value:       change.NewValue,
+	})
+}
@@ -0,0 +1,10 @@
+package types
+
+import "errors"
+
+// Errors for writes that would exceed the limits in Params.
+var (
+	ErrValueTooLarge   = errors.New("vstorage value too large")
+	ErrTooManyChildren = errors.New("vstorage path has too many children")
+	ErrSubtreeTooLarge = errors.New("vstorage subtree too large")
+)
@@ -6,4 +6,8 @@ const (
 
 	// StoreKey to be used when creating the KVStore
 	StoreKey = ModuleName
+
+	// MetaStoreKey to be used when creating the KVStore of bookkeeping about
+	// the paths of the StoreKey KVStore, which holds nothing but paths.
+	MetaStoreKey = "meta_" + ModuleName
 )
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"fmt"
+
+	yaml "gopkg.in/yaml.v2"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter keys
+var (
+	ParamStoreKeyMaxValueBytes   = []byte("max_value_bytes")
+	ParamStoreKeyMaxChildren     = []byte("max_children")
+	ParamStoreKeyMaxSubtreeBytes = []byte("max_subtree_bytes")
+)
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns default vstorage parameters, which impose no limits.
+func DefaultParams() Params {
+	return Params{
+		MaxValueBytes:   0,
+		MaxChildren:     0,
+		MaxSubtreeBytes: 0,
+	}
+}
+
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxValueBytes, &p.MaxValueBytes, validateLimit),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxChildren, &p.MaxChildren, validateLimit),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxSubtreeBytes, &p.MaxSubtreeBytes, validateLimit),
+	}
+}
+
+// ValidateBasic performs basic validation on vstorage parameters.
+func (p Params) ValidateBasic() error {
+	for _, limit := range []uint64{p.MaxValueBytes, p.MaxChildren, p.MaxSubtreeBytes} {
+		if err := validateLimit(limit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateLimit accepts any uint64, since zero means no limit.
+func validateLimit(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
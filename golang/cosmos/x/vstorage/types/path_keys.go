@@ -34,11 +34,20 @@ import (
 // empty data). Placeholder entries are used when a descendant with data exists,
 // similar to empty non-terminals in the DNS
 // (cf. https://www.rfc-editor.org/rfc/rfc8499.html#section-7 ).
+//
+// - Bookkeeping about paths is kept in a separate "meta" store, so that it
+// cannot be confused with path entries.  The total bytes used under each
+// top-level path are kept at SubtreeBytesKeyPrefix followed by that path, and
+// the number of children of each path with any are kept at
+// ChildCountKeyPrefix followed by its encoded key, both as big-endian uint64.
 var (
 	EncodedKeySeparator = []byte{0}
 	PathSeparator       = "."
 	EncodedDataPrefix   = []byte{0}
 	EncodedNoDataValue  = []byte{255}
+
+	SubtreeBytesKeyPrefix = []byte{0x01}
+	ChildCountKeyPrefix   = []byte{0x02}
 )
 
 // EncodedKeyToPath converts a byte slice key to a string path
@@ -89,15 +98,56 @@ func PathToEncodedKey(path string) []byte {
 
 // PathToChildrenPrefix converts a path to a prefix for its children
 func PathToChildrenPrefix(path string) []byte {
+	return PathToDescendantsPrefix(path, 1)
+}
+
+// PathToDescendantsPrefix converts a path to a prefix for its descendants
+// that are a given number of generations below it (1 for children, 2 for
+// grandchildren, etc.).
+// Because an encoded key starts with its depth, the descendants of a path
+// do not share a single prefix, but those at each depth do.
+func PathToDescendantsPrefix(path string, generations int) []byte {
 	if err := ValidatePath(path); err != nil {
 		panic(err)
 	}
+	if generations < 1 {
+		panic(fmt.Errorf("generations %d must be positive", generations))
+	}
 	encodedPrefix := PathSeparator + path
+	depth := generations
 	if len(path) > 0 {
 		// Append so that only the empty prefix has no trailing separator.
 		encodedPrefix += PathSeparator
+		depth += strings.Count(path, PathSeparator) + 1
 	}
-	depth := strings.Count(encodedPrefix, PathSeparator)
 	encoded := []byte(fmt.Sprintf("%d%s", depth, encodedPrefix))
 	return bytes.ReplaceAll(encoded, []byte(PathSeparator), EncodedKeySeparator)
 }
+
+// PathToTopLevelPath returns the first segment of a path, which is the
+// top-level path whose subtree contains it (or empty for the empty path).
+func PathToTopLevelPath(path string) string {
+	topLevel, _, _ := strings.Cut(path, PathSeparator)
+	return topLevel
+}
+
+// TopLevelPathToSubtreeBytesKey converts a top-level path to the meta store
+// key of the total bytes used in its subtree.
+func TopLevelPathToSubtreeBytesKey(topLevel string) []byte {
+	return append(append([]byte{}, SubtreeBytesKeyPrefix...), topLevel...)
+}
+
+// EncodedKeyToChildCountKey converts the encoded key of a path to the meta
+// store key of its number of children.
+func EncodedKeyToChildCountKey(encodedKey []byte) []byte {
+	return append(append([]byte{}, ChildCountKeyPrefix...), encodedKey...)
+}
+
+// PathToParentPath returns the parent of a non-empty path.
+func PathToParentPath(path string) string {
+	separatorIndex := strings.LastIndex(path, PathSeparator)
+	if separatorIndex < 0 {
+		return ""
+	}
+	return path[:separatorIndex]
+}
@@ -105,3 +105,29 @@ func Test_Key_Encoding(t *testing.T) {
 		})
 	}
 }
+
+func Test_Descendants_Prefix(t *testing.T) {
+	tests := []struct {
+		path        string
+		generations int
+		prefix      []byte
+	}{
+		{path: "", generations: 1, prefix: []byte("1\x00")},
+		{path: "", generations: 3, prefix: []byte("3\x00")},
+		{path: "some", generations: 1, prefix: []byte("2\x00some\x00")},
+		{path: "some.child", generations: 1, prefix: []byte("3\x00some\x00child\x00")},
+		{path: "some.child", generations: 9, prefix: []byte("11\x00some\x00child\x00")},
+	}
+
+	for _, tt := range tests {
+		prefix := PathToDescendantsPrefix(tt.path, tt.generations)
+		if !bytes.Equal(prefix, tt.prefix) {
+			t.Errorf("PathToDescendantsPrefix(%q, %d) = []byte(%q), want []byte(%q)", tt.path, tt.generations, prefix, tt.prefix)
+		}
+		if tt.generations == 1 {
+			if prefix := PathToChildrenPrefix(tt.path); !bytes.Equal(prefix, tt.prefix) {
+				t.Errorf("PathToChildrenPrefix(%q) = []byte(%q), want []byte(%q)", tt.path, prefix, tt.prefix)
+			}
+		}
+	}
+}
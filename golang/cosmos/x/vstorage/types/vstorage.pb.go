@@ -114,33 +114,198 @@ func (m *Children) GetChildren() []string {
 	return nil
 }
 
+// Params are the vstorage module parameters, limiting the data that the VM
+// may write through the bridge.  A zero value means no limit.
+type Params struct {
+	// The maximum number of bytes in the data at any path.
+	MaxValueBytes uint64 `protobuf:"varint,1,opt,name=max_value_bytes,json=maxValueBytes,proto3" json:"max_value_bytes" yaml:"max_value_bytes"`
+	// The maximum number of children of any path.
+	MaxChildren uint64 `protobuf:"varint,2,opt,name=max_children,json=maxChildren,proto3" json:"max_children" yaml:"max_children"`
+	// The maximum total number of bytes in the paths and data of all the
+	// entries with data under any top-level path (including itself).
+	MaxSubtreeBytes uint64 `protobuf:"varint,3,opt,name=max_subtree_bytes,json=maxSubtreeBytes,proto3" json:"max_subtree_bytes" yaml:"max_subtree_bytes"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7f80259d2fe3898c, []int{2}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxValueBytes() uint64 {
+	if m != nil {
+		return m.MaxValueBytes
+	}
+	return 0
+}
+
+func (m *Params) GetMaxChildren() uint64 {
+	if m != nil {
+		return m.MaxChildren
+	}
+	return 0
+}
+
+func (m *Params) GetMaxSubtreeBytes() uint64 {
+	if m != nil {
+		return m.MaxSubtreeBytes
+	}
+	return 0
+}
+
+// SubtreeUsage is the storage used under a top-level vstorage path.
+type SubtreeUsage struct {
+	// The top-level path.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path" yaml:"path"`
+	// The total number of bytes in the paths and data of all the entries with
+	// data under the path (including itself), as limited by
+	// Params.max_subtree_bytes.
+	Bytes uint64 `protobuf:"varint,2,opt,name=bytes,proto3" json:"bytes" yaml:"bytes"`
+}
+
+func (m *SubtreeUsage) Reset()         { *m = SubtreeUsage{} }
+func (m *SubtreeUsage) String() string { return proto.CompactTextString(m) }
+func (*SubtreeUsage) ProtoMessage()    {}
+func (*SubtreeUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7f80259d2fe3898c, []int{3}
+}
+func (m *SubtreeUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubtreeUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubtreeUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubtreeUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubtreeUsage.Merge(m, src)
+}
+func (m *SubtreeUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubtreeUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubtreeUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubtreeUsage proto.InternalMessageInfo
+
+func (m *SubtreeUsage) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *SubtreeUsage) GetBytes() uint64 {
+	if m != nil {
+		return m.Bytes
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Data)(nil), "agoric.vstorage.Data")
 	proto.RegisterType((*Children)(nil), "agoric.vstorage.Children")
+	proto.RegisterType((*Params)(nil), "agoric.vstorage.Params")
+	proto.RegisterType((*SubtreeUsage)(nil), "agoric.vstorage.SubtreeUsage")
 }
 
 func init() { proto.RegisterFile("agoric/vstorage/vstorage.proto", fileDescriptor_7f80259d2fe3898c) }
 
 var fileDescriptor_7f80259d2fe3898c = []byte{
-	// 245 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0x4c, 0xcf, 0x2f,
-	0xca, 0x4c, 0xd6, 0x2f, 0x2b, 0x2e, 0xc9, 0x2f, 0x4a, 0x4c, 0x4f, 0x85, 0x33, 0xf4, 0x0a, 0x8a,
-	0xf2, 0x4b, 0xf2, 0x85, 0xf8, 0x21, 0xf2, 0x7a, 0x30, 0x61, 0x29, 0x91, 0xf4, 0xfc, 0xf4, 0x7c,
-	0xb0, 0x9c, 0x3e, 0x88, 0x05, 0x51, 0xa6, 0x64, 0xcb, 0xc5, 0xe2, 0x92, 0x58, 0x92, 0x28, 0xa4,
-	0xcf, 0xc5, 0x5a, 0x96, 0x98, 0x53, 0x9a, 0x2a, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0xe9, 0x24, 0xf9,
-	0xea, 0x9e, 0x3c, 0x44, 0xe0, 0xd3, 0x3d, 0x79, 0x9e, 0xca, 0xc4, 0xdc, 0x1c, 0x2b, 0x25, 0x30,
-	0x57, 0x29, 0x08, 0x22, 0x6c, 0xc5, 0xf2, 0x62, 0x81, 0x3c, 0x83, 0x92, 0x2f, 0x17, 0x87, 0x73,
-	0x46, 0x66, 0x4e, 0x4a, 0x51, 0x6a, 0x9e, 0x90, 0x35, 0x17, 0x47, 0x32, 0x94, 0x2d, 0xc1, 0xa8,
-	0xc0, 0xac, 0xc1, 0xe9, 0x24, 0xff, 0xea, 0x9e, 0x3c, 0x5c, 0xec, 0xd3, 0x3d, 0x79, 0x7e, 0x88,
-	0x41, 0x30, 0x11, 0xa5, 0x20, 0xb8, 0x24, 0xc4, 0x38, 0xa7, 0xd0, 0x13, 0x8f, 0xe4, 0x18, 0x2f,
-	0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18,
-	0x6e, 0x3c, 0x96, 0x63, 0x88, 0xb2, 0x4e, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf,
-	0xd5, 0x77, 0x84, 0xf8, 0x1c, 0xe2, 0x41, 0xdd, 0xe2, 0x94, 0x6c, 0xfd, 0xf4, 0xfc, 0x9c, 0xc4,
-	0xbc, 0x74, 0xfd, 0xe4, 0xfc, 0xe2, 0xdc, 0xfc, 0x62, 0xfd, 0x0a, 0x44, 0xa0, 0x94, 0x54, 0x16,
-	0xa4, 0x16, 0x27, 0xb1, 0x81, 0xfd, 0x6a, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x81, 0x6f, 0xc9,
-	0xde, 0x34, 0x01, 0x00, 0x00,
+	// 428 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0xcf, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x93, 0xdd, 0xb8, 0x74, 0x67, 0x2b, 0xc5, 0x28, 0x5a, 0x3d, 0xe4, 0x2d, 0x73, 0x71,
+	0x41, 0xb6, 0x41, 0xbc, 0x75, 0xf1, 0x60, 0xf4, 0x24, 0x08, 0x12, 0xa9, 0x07, 0x41, 0x96, 0x49,
+	0x36, 0x4c, 0x8b, 0xc9, 0x4e, 0xc9, 0x4c, 0x97, 0xf4, 0xbf, 0xf0, 0xe0, 0xc1, 0x63, 0xff, 0x1c,
+	0x8f, 0x3d, 0x7a, 0x1a, 0xa4, 0xbd, 0x94, 0x1c, 0xf3, 0x17, 0xc8, 0xcc, 0xe4, 0x07, 0xed, 0xde,
+	0xde, 0xfb, 0x7c, 0x87, 0xef, 0x7c, 0x1f, 0xef, 0x21, 0x8f, 0x50, 0x96, 0xcf, 0x62, 0xff, 0x8e,
+	0x0b, 0x96, 0x13, 0x9a, 0xb4, 0xc5, 0x68, 0x9e, 0x33, 0xc1, 0xdc, 0x81, 0xd1, 0x47, 0x0d, 0x7e,
+	0xf1, 0x84, 0x32, 0xca, 0xb4, 0xe6, 0xab, 0xca, 0x3c, 0xc3, 0x6f, 0x91, 0xf3, 0x81, 0x08, 0xe2,
+	0xfa, 0xe8, 0xc1, 0x1d, 0x49, 0x17, 0xc9, 0xd0, 0x3e, 0xb7, 0x2f, 0x4e, 0x83, 0xe7, 0xa5, 0x04,
+	0x03, 0x2a, 0x09, 0xfd, 0x25, 0xc9, 0xd2, 0x31, 0xd6, 0x2d, 0x0e, 0x0d, 0x1e, 0x3b, 0xbb, 0x15,
+	0x58, 0xf8, 0x13, 0xea, 0xbd, 0x9f, 0xce, 0xd2, 0x9b, 0x3c, 0xb9, 0x75, 0xaf, 0x50, 0x2f, 0xae,
+	0xeb, 0xa1, 0x7d, 0x7e, 0x7c, 0x71, 0x1a, 0x40, 0x29, 0xa1, 0x65, 0x95, 0x84, 0x81, 0x31, 0x6a,
+	0x08, 0x0e, 0x5b, 0xb1, 0xb6, 0xfb, 0x75, 0x84, 0x4e, 0x3e, 0x93, 0x9c, 0x64, 0xdc, 0x9d, 0xa0,
+	0x41, 0x46, 0x8a, 0x6b, 0xfd, 0xd9, 0x75, 0xb4, 0x14, 0x09, 0xd7, 0xd1, 0x9c, 0xe0, 0xb2, 0x94,
+	0x70, 0x28, 0x55, 0x12, 0x9e, 0x1a, 0xef, 0x03, 0x01, 0x87, 0x0f, 0x33, 0x52, 0x7c, 0x55, 0x20,
+	0x50, 0xbd, 0xfb, 0x11, 0xf5, 0xd5, 0x93, 0x36, 0xe8, 0x91, 0xf6, 0x7c, 0x59, 0x4a, 0xd8, 0xe3,
+	0x95, 0x84, 0xc7, 0x9d, 0x61, 0x17, 0xf8, 0x2c, 0x23, 0x45, 0x3b, 0xf0, 0x77, 0xf4, 0x48, 0xa9,
+	0x7c, 0x11, 0x89, 0x3c, 0x69, 0x42, 0x1e, 0x6b, 0xc3, 0xd7, 0xa5, 0x84, 0xfb, 0x62, 0x25, 0x61,
+	0xd8, 0xb9, 0xee, 0x49, 0x38, 0x54, 0x33, 0x7d, 0x31, 0x48, 0x47, 0x1d, 0xf7, 0x7e, 0xaf, 0xc0,
+	0xda, 0xad, 0xc0, 0xc6, 0x39, 0xea, 0xd7, 0xca, 0x84, 0x13, 0x9a, 0xb8, 0xaf, 0x90, 0x33, 0x27,
+	0x62, 0x5a, 0xef, 0xea, 0x59, 0x29, 0x41, 0xf7, 0x95, 0x84, 0x33, 0x63, 0xaf, 0x3a, 0x1c, 0x6a,
+	0xa8, 0x36, 0x6b, 0x92, 0x99, 0x51, 0xf5, 0x66, 0x9b, 0x34, 0xf5, 0x66, 0xeb, 0x04, 0x06, 0x9b,
+	0x55, 0x04, 0x93, 0x3f, 0x1b, 0xcf, 0x5e, 0x6f, 0x3c, 0xfb, 0xdf, 0xc6, 0xb3, 0x7f, 0x6e, 0x3d,
+	0x6b, 0xbd, 0xf5, 0xac, 0xbf, 0x5b, 0xcf, 0xfa, 0x76, 0x45, 0x67, 0x62, 0xba, 0x88, 0x46, 0x31,
+	0xcb, 0xfc, 0x77, 0xe6, 0x08, 0xcd, 0xad, 0x5d, 0xf2, 0x9b, 0x1f, 0x3e, 0x65, 0x29, 0xb9, 0xa5,
+	0x7e, 0xcc, 0x78, 0xc6, 0xb8, 0x5f, 0x74, 0xf7, 0x29, 0x96, 0xf3, 0x84, 0x47, 0x27, 0xfa, 0xec,
+	0xde, 0xfc, 0x1f, 0x00, 0x94, 0x23, 0x5f, 0xba, 0xbf, 0x02, 0x00, 0x00,
 }
 
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxValueBytes != that1.MaxValueBytes {
+		return false
+	}
+	if this.MaxChildren != that1.MaxChildren {
+		return false
+	}
+	if this.MaxSubtreeBytes != that1.MaxSubtreeBytes {
+		return false
+	}
+	return true
+}
 func (m *Data) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -203,6 +368,79 @@ func (m *Children) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxSubtreeBytes != 0 {
+		i = encodeVarintVstorage(dAtA, i, uint64(m.MaxSubtreeBytes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxChildren != 0 {
+		i = encodeVarintVstorage(dAtA, i, uint64(m.MaxChildren))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxValueBytes != 0 {
+		i = encodeVarintVstorage(dAtA, i, uint64(m.MaxValueBytes))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubtreeUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubtreeUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubtreeUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Bytes != 0 {
+		i = encodeVarintVstorage(dAtA, i, uint64(m.Bytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintVstorage(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintVstorage(dAtA []byte, offset int, v uint64) int {
 	offset -= sovVstorage(v)
 	base := offset
@@ -242,6 +480,40 @@ func (m *Children) Size() (n int) {
 	return n
 }
 
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxValueBytes != 0 {
+		n += 1 + sovVstorage(uint64(m.MaxValueBytes))
+	}
+	if m.MaxChildren != 0 {
+		n += 1 + sovVstorage(uint64(m.MaxChildren))
+	}
+	if m.MaxSubtreeBytes != 0 {
+		n += 1 + sovVstorage(uint64(m.MaxSubtreeBytes))
+	}
+	return n
+}
+
+func (m *SubtreeUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovVstorage(uint64(l))
+	}
+	if m.Bytes != 0 {
+		n += 1 + sovVstorage(uint64(m.Bytes))
+	}
+	return n
+}
+
 func sovVstorage(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -412,6 +684,214 @@ func (m *Children) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVstorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValueBytes", wireType)
+			}
+			m.MaxValueBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVstorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValueBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChildren", wireType)
+			}
+			m.MaxChildren = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVstorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxChildren |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSubtreeBytes", wireType)
+			}
+			m.MaxSubtreeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVstorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSubtreeBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVstorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVstorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubtreeUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVstorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubtreeUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubtreeUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVstorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVstorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVstorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
+			}
+			m.Bytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVstorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVstorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVstorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipVstorage(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
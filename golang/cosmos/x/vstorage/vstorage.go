@@ -41,6 +41,45 @@ func unmarshalSinglePathFromArgs(args []json.RawMessage, path *string) error {
 	return json.Unmarshal(args[0], path)
 }
 
+// setEntries sets a batch of entries in order with set, after checking that
+// none of them would exceed a storage quota so that either all or none of
+// them are set.  If toEntry is not nil, it computes each entry to set from an
+// argument and the state left by the entries before it.
+func setEntries(
+	ctx sdk.Context,
+	keeper Keeper,
+	args []json.RawMessage,
+	toEntry func(ctx sdk.Context, entry agoric.KVEntry) (agoric.KVEntry, error),
+	set func(ctx sdk.Context, entry agoric.KVEntry),
+) error {
+	checkCtx, _ := ctx.CacheContext()
+	entries := make([]agoric.KVEntry, 0, len(args))
+	for _, arg := range args {
+		var entry agoric.KVEntry
+		err := json.Unmarshal(arg, &entry)
+		if err != nil {
+			return err
+		}
+		if toEntry != nil {
+			entry, err = toEntry(checkCtx, entry)
+			if err != nil {
+				return err
+			}
+		}
+		err = keeper.CheckStorageQuota(checkCtx, entry)
+		if err != nil {
+			return err
+		}
+		keeper.SetStorage(checkCtx, entry)
+		entries = append(entries, entry)
+	}
+
+	for _, entry := range entries {
+		set(ctx, entry)
+	}
+	return nil
+}
+
 func (sh vstorageHandler) Receive(cctx context.Context, str string) (ret string, err error) {
 	ctx := sdk.UnwrapSDKContext(cctx)
 	keeper := sh.keeper
@@ -69,13 +108,9 @@ func (sh vstorageHandler) Receive(cctx context.Context, str string) (ret string,
 	// Handle generic paths.
 	switch msg.Method {
 	case "set":
-		for _, arg := range msg.Args {
-			var entry agoric.KVEntry
-			err = json.Unmarshal(arg, &entry)
-			if err != nil {
-				return
-			}
-			keeper.SetStorageAndNotify(ctx, entry)
+		err = setEntries(ctx, keeper, msg.Args, nil, keeper.SetStorageAndNotify)
+		if err != nil {
+			return
 		}
 		return "true", nil
 
@@ -83,43 +118,29 @@ func (sh vstorageHandler) Receive(cctx context.Context, str string) (ret string,
 		// chain-cosmos-sdk.js consumes legacy events for `mailbox.*` and `egress.*`.
 		// FIXME: Use just "set" and remove this case.
 	case "legacySet":
-		for _, arg := range msg.Args {
-			var entry agoric.KVEntry
-			err = json.Unmarshal(arg, &entry)
-			if err != nil {
-				return
-			}
-			//fmt.Printf("giving Keeper.SetStorage(%s) %s\n", entry.Path(), entry.Value())
-			keeper.LegacySetStorageAndNotify(ctx, entry)
+		err = setEntries(ctx, keeper, msg.Args, nil, keeper.LegacySetStorageAndNotify)
+		if err != nil {
+			return
 		}
 		return "true", nil
 
 	case "setWithoutNotify":
-		for _, arg := range msg.Args {
-			var entry agoric.KVEntry
-			err = json.Unmarshal(arg, &entry)
-			if err != nil {
-				return
-			}
-			keeper.SetStorage(ctx, entry)
+		err = setEntries(ctx, keeper, msg.Args, nil, keeper.SetStorage)
+		if err != nil {
+			return
 		}
 		return "true", nil
 
 	case "append":
-		for _, arg := range msg.Args {
-			var entry agoric.KVEntry
-			err = json.Unmarshal(arg, &entry)
-			if err != nil {
-				return
-			}
+		appended := func(ctx sdk.Context, entry agoric.KVEntry) (agoric.KVEntry, error) {
 			if !entry.HasValue() {
-				err = fmt.Errorf("no value for append entry with path: %q", entry.Key())
-				return
-			}
-			err = keeper.AppendStorageValueAndNotify(ctx, entry.Key(), entry.StringValue())
-			if err != nil {
-				return
+				return agoric.KVEntry{}, fmt.Errorf("no value for append entry with path: %q", entry.Key())
 			}
+			return keeper.AppendedStorageEntry(ctx, entry.Key(), entry.StringValue())
+		}
+		err = setEntries(ctx, keeper, msg.Args, appended, keeper.SetStorageAndNotify)
+		if err != nil {
+			return
 		}
 		return "true", nil
 
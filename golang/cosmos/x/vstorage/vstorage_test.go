@@ -10,9 +10,13 @@ import (
 
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/types"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/store"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	agorictypes "github.com/Agoric/agoric-sdk/golang/cosmos/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -21,7 +25,10 @@ import (
 )
 
 var (
-	storeKey = storetypes.NewKVStoreKey(types.StoreKey)
+	storeKey        = storetypes.NewKVStoreKey(types.StoreKey)
+	metaStoreKey    = storetypes.NewKVStoreKey(types.MetaStoreKey)
+	paramsStoreKey  = storetypes.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTStoreKey = storetypes.NewTransientStoreKey(paramstypes.TStoreKey)
 )
 
 func ptr[T any](v T) *T {
@@ -36,15 +43,20 @@ type testKit struct {
 }
 
 func makeTestKit() testKit {
-	keeper := NewKeeper(storeKey)
+	pk := paramskeeper.NewKeeper(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), codec.NewLegacyAmino(), paramsStoreKey, paramsTStoreKey)
+	keeper := NewKeeper(storeKey, metaStoreKey, pk.Subspace(types.ModuleName))
 	db := dbm.NewMemDB()
 	ms := store.NewCommitMultiStore(db)
 	ms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(metaStoreKey, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsStoreKey, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsTStoreKey, storetypes.StoreTypeTransient, db)
 	err := ms.LoadLatestVersion()
 	if err != nil {
 		panic(err)
 	}
 	ctx := sdk.NewContext(ms, tmproto.Header{}, false, log.NewNopLogger())
+	keeper.SetParams(ctx, types.DefaultParams())
 	cctx := sdk.WrapSDKContext(ctx)
 	handler := vstorageHandler{keeper}
 	return testKit{keeper, handler, ctx, cctx}
@@ -251,6 +263,104 @@ func TestSetWithoutNotify(t *testing.T) {
 	doTestSet(t, "setWithoutNotify", false)
 }
 
+func TestQuota(t *testing.T) {
+	kit := makeTestKit()
+	keeper, handler, ctx, cctx := kit.keeper, kit.handler, kit.ctx, kit.cctx
+
+	keeper.SetParams(ctx, types.Params{MaxValueBytes: 40, MaxChildren: 2, MaxSubtreeBytes: 90})
+
+	type testCase struct {
+		label       string
+		method      string
+		args        []interface{}
+		errContains *string
+	}
+	maxValue := strings.Repeat("v", 40)
+	cases := []testCase{
+		{label: "max value",
+			method: "set",
+			args:   []interface{}{[]string{"a.x", maxValue}},
+		},
+		{label: "large value",
+			method:      "set",
+			args:        []interface{}{[]string{"b", maxValue + "v"}},
+			errContains: ptr(types.ErrValueTooLarge.Error()),
+		},
+		{label: "second child",
+			method: "setWithoutNotify",
+			args:   []interface{}{[]string{"a.y", "1"}},
+		},
+		{label: "third child",
+			method:      "legacySet",
+			args:        []interface{}{[]string{"a.z.deep", "1"}},
+			errContains: ptr(types.ErrTooManyChildren.Error()),
+		},
+		{label: "grandchild",
+			method: "set",
+			args:   []interface{}{[]string{"a.y.z", "1"}},
+		},
+		{label: "subtree overflow",
+			method:      "set",
+			args:        []interface{}{[]string{"a", maxValue}},
+			errContains: ptr(types.ErrSubtreeTooLarge.Error()),
+		},
+		{label: "shrink",
+			method: "set",
+			args:   []interface{}{[]string{"a.x", "1"}},
+		},
+		{label: "append within subtree",
+			method: "append",
+			args:   []interface{}{[]string{"a.y", "1"}},
+		},
+		{label: "append overflow",
+			method:      "append",
+			args:        []interface{}{[]string{"a.y", "12345678"}},
+			errContains: ptr(types.ErrValueTooLarge.Error()),
+		},
+		{label: "delete",
+			method: "set",
+			args:   []interface{}{[]string{"a.y"}},
+		},
+		{label: "other subtree",
+			method: "set",
+			args:   []interface{}{[]string{"b", maxValue}},
+		},
+		{label: "batch with last entry over quota",
+			method: "set",
+			args: []interface{}{
+				[]string{"c.1", "1"},
+				[]string{"c.2", "2"},
+				[]string{"c.3", "3"},
+			},
+			errContains: ptr(types.ErrTooManyChildren.Error()),
+		},
+	}
+	for _, desc := range cases {
+		_, err := callReceive(handler, cctx, desc.method, desc.args)
+		if desc.errContains == nil {
+			if err != nil {
+				t.Errorf("%s: got unexpected error %v", desc.label, err)
+			}
+		} else if err == nil {
+			t.Errorf("%s: got no error, want error %q", desc.label, *desc.errContains)
+		} else if !strings.Contains(err.Error(), *desc.errContains) {
+			t.Errorf("%s: got error %v, want error %q", desc.label, err, *desc.errContains)
+		}
+	}
+
+	// A batch that fails a quota sets none of its entries.
+	if keeper.HasEntry(ctx, "c") {
+		t.Errorf("got entry %q from a failed batch", "c")
+	}
+
+	// Only "a.x" and "a.y.z" have data under "a".
+	for path, want := range map[string]uint64{"a": 4 + 6, "b": 41} {
+		if got := keeper.GetSubtreeBytes(ctx, path); got != want {
+			t.Errorf("subtree %q: got %d bytes, want %d", path, got, want)
+		}
+	}
+}
+
 // TODO: TestAppend
 
 // TODO: TestChildrenAndSize
@@ -362,6 +362,22 @@ export default async function main(progname, args, { env, homedir, agcc }) {
       return publisher;
     };
 
+    /**
+     * Tell x/swingset that the controller has installed a bundle submitted by
+     * MsgInstallBundle, so that it can record the installation.
+     *
+     * @param {{ id: string, submitter: string, size: number }} installed
+     */
+    const recordInstalledBundle = installed => {
+      chainSend(
+        portNums.swingset,
+        stringify({
+          method: 'bundleInstalled',
+          args: [installed],
+        }),
+      );
+    };
+
     const argv = {
       bootMsg,
     };
@@ -468,6 +484,7 @@ export default async function main(progname, args, { env, homedir, agcc }) {
       highPriorityQueueStorage,
       kernelStateDBDir: stateDBDir,
       makeInstallationPublisher,
+      recordInstalledBundle,
       mailboxStorage,
       clearChainSends,
       replayChainSends,
@@ -98,6 +98,8 @@ export const checkExportDataMode = (mode, isImport = false) => {
  * @property {string} [data] file name containing the swingStore "export data"
  * @property {Array<[artifactName: string, fileName: string]>} artifacts
  *   List of swingStore export artifacts which can be validated by the export data
+ * @property {string[]} [parentArtifacts] names of the swingStore export
+ *   artifacts omitted because they are part of the parent export
  */
 
 /**
@@ -115,6 +117,7 @@ export const checkExportDataMode = (mode, isImport = false) => {
  * @property {number} [blockHeight] block height to check for
  * @property {SwingStoreArtifactMode} [artifactMode] the level of artifacts to include in the export
  * @property {SwingStoreExportDataMode} [exportDataMode] include a synthetic artifact for the export data in the export
+ * @property {string} [parentExportDir] the directory of a previous export whose artifacts are not exported again
  */
 
 /**
@@ -132,6 +135,9 @@ export const validateExporterOptions = options => {
     Fail`optional blockHeight option not a number`;
   checkArtifactMode(options.artifactMode);
   checkExportDataMode(options.exportDataMode);
+  options.parentExportDir == null ||
+    typeof options.parentExportDir === 'string' ||
+    Fail`optional parentExportDir option not a string`;
 
   options.includeExportData === undefined ||
     Fail`deprecated includeExportData option found`;
@@ -141,16 +147,23 @@ export const validateExporterOptions = options => {
 /**
  * @param {StateSyncExporterOptions} options
  * @param {object} powers
- * @param {Pick<import('fs/promises'), 'open' | 'writeFile'>} powers.fs
+ * @param {Pick<import('fs/promises'), 'open' | 'readFile' | 'writeFile'>} powers.fs
  * @param {import('path')['resolve']} powers.pathResolve
  * @param {typeof import('@agoric/swing-store')['makeSwingStoreExporter']} [powers.makeSwingStoreExporter]
  * @param {null | ((...args: any[]) => void)} [powers.log]
  * @returns {StateSyncExporter}
  */
 export const initiateSwingStoreExport = (
-  { stateDir, exportDir, blockHeight, artifactMode, exportDataMode },
   {
-    fs: { open, writeFile },
+    stateDir,
+    exportDir,
+    blockHeight,
+    artifactMode,
+    exportDataMode,
+    parentExportDir,
+  },
+  {
+    fs: { open, readFile, writeFile },
     pathResolve,
     makeSwingStoreExporter: makeExporter = makeSwingStoreExporter,
     log = console.log,
@@ -218,9 +231,34 @@ export const initiateSwingStoreExport = (
     }
     abortIfStopped();
 
+    // Artifact names identify immutable content, so those of the parent
+    // export, including the ones it omitted itself, are unchanged.
+    /** @type {Set<string>} */
+    const parentArtifactNames = new Set();
+    if (parentExportDir) {
+      /** @type {StateSyncManifest} */
+      const parentManifest = JSON.parse(
+        await readFile(
+          pathResolve(parentExportDir, ExportManifestFileName),
+          'utf-8',
+        ),
+      );
+      for (const [artifactName] of parentManifest.artifacts) {
+        parentArtifactNames.add(artifactName);
+      }
+      for (const artifactName of parentManifest.parentArtifacts || []) {
+        parentArtifactNames.add(artifactName);
+      }
+      manifest.parentArtifacts = [];
+    }
+
     if (artifactMode !== 'none') {
       for await (const artifactName of swingStoreExporter.getArtifactNames()) {
         abortIfStopped();
+        if (parentArtifactNames.has(artifactName)) {
+          manifest.parentArtifacts?.push(artifactName);
+          continue;
+        }
         log?.(`Writing artifact: ${artifactName}`);
         const artifactData = swingStoreExporter.getArtifact(artifactName);
         // Use artifactName as the file name as we trust swingStore to generate
@@ -325,6 +363,8 @@ export const main = async (
     throw Fail`deprecated "export-mode" options, use "artifact-mode" instead`;
   }
 
+  const parentExportDir = processValue.getFlag('parent-export-dir');
+
   const checkBlockHeight = processValue.getInteger({
     flagName: 'check-block-height',
   });
@@ -342,6 +382,7 @@ export const main = async (
       blockHeight: checkBlockHeight,
       artifactMode,
       exportDataMode,
+      parentExportDir,
     },
     {
       fs,
@@ -382,7 +423,14 @@ export const main = async (
  * @returns {StateSyncExporter}
  */
 export const spawnSwingStoreExport = (
-  { stateDir, exportDir, blockHeight, artifactMode, exportDataMode },
+  {
+    stateDir,
+    exportDir,
+    blockHeight,
+    artifactMode,
+    exportDataMode,
+    parentExportDir,
+  },
   { fork, verbose },
 ) => {
   const args = ['--state-dir', stateDir, '--export-dir', exportDir];
@@ -399,6 +447,10 @@ export const spawnSwingStoreExport = (
     args.push('--export-data-mode', exportDataMode);
   }
 
+  if (parentExportDir) {
+    args.push('--parent-export-dir', parentExportDir);
+  }
+
   if (verbose) {
     args.push('--verbose');
   }
@@ -321,6 +321,7 @@ export async function launch({
   replayChainSends,
   bridgeOutbound,
   makeInstallationPublisher,
+  recordInstalledBundle,
   vatconfig,
   argv,
   env = process.env,
@@ -506,7 +507,7 @@ export async function launch({
     bridgeInbound(source, body);
   }
 
-  async function installBundle(bundleJson) {
+  async function installBundle(bundleJson, submitter) {
     let bundle;
     try {
       bundle = JSON.parse(bundleJson);
@@ -523,6 +524,14 @@ export async function launch({
 
     const { endoZipBase64Sha512 } = bundle;
 
+    if (error === null && recordInstalledBundle && submitter) {
+      recordInstalledBundle({
+        id: `b1-${endoZipBase64Sha512}`,
+        submitter,
+        size: new TextEncoder().encode(bundleJson).length,
+      });
+    }
+
     if (installationPublisher === undefined) {
       return;
     }
@@ -587,7 +596,7 @@ export async function launch({
       }
 
       case ActionType.INSTALL_BUNDLE: {
-        p = installBundle(action.bundle);
+        p = installBundle(action.bundle, action.submitter);
         break;
       }
 
@@ -606,6 +615,11 @@ export async function launch({
         break;
       }
 
+      case ActionType.WALLET_ACTION_BATCH: {
+        p = doBridgeInbound(BRIDGE_ID.WALLET, action, inboundNum);
+        break;
+      }
+
       default: {
         Fail`${action.type} not recognized`;
       }
@@ -14,4 +14,5 @@ export const PLEASE_PROVISION = 'PLEASE_PROVISION';
 export const VBANK_BALANCE_UPDATE = 'VBANK_BALANCE_UPDATE';
 export const WALLET_ACTION = 'WALLET_ACTION';
 export const WALLET_SPEND_ACTION = 'WALLET_SPEND_ACTION';
+export const WALLET_ACTION_BATCH = 'WALLET_ACTION_BATCH';
 export const INSTALL_BUNDLE = 'INSTALL_BUNDLE';
@@ -131,9 +131,16 @@ const trace = makeTracer('SmrtWlt');
 /**
  * @typedef {{ updated: 'offerStatus'; status: OfferStatus }
  *   | { updated: 'balance'; currentAmount: Amount }
- *   | { updated: 'walletAction'; status: { error: string } }} UpdateRecord
+ *   | {
+ *       updated: 'walletAction';
+ *       correlationId?: string;
+ *       status: { error: string } | { done: true };
+ *     }} UpdateRecord
  *   Record of an update to the state of this wallet.
  *
+ *   A 'walletAction' update reports the failure of an action, or the outcome
+ *   of an action of a batch, identified by its correlationId.
+ *
  *   Client is responsible for coalescing updates into a current state. See
  *   `coalesceUpdates` utility.
  *
@@ -471,9 +478,9 @@ export const prepareSmartWallet = (baggage, shared) => {
       tryExitOffer: M.call(M.scalar()).returns(M.promise()),
     }),
     self: M.interface('selfFacetI', {
-      handleBridgeAction: M.call(shape.StringCapData, M.boolean()).returns(
-        M.promise(),
-      ),
+      handleBridgeAction: M.call(shape.StringCapData, M.boolean())
+        .optional(M.string())
+        .returns(M.promise()),
       getDepositFacet: M.call().returns(M.remotable()),
       getOffersFacet: M.call().returns(M.remotable()),
       getCurrentSubscriber: M.call().returns(SubscriberShape),
@@ -1120,25 +1127,32 @@ export const prepareSmartWallet = (baggage, shared) => {
          * @param {import('@endo/marshal').CapData<string | null>} actionCapData
          *   of type BridgeAction
          * @param {boolean} [canSpend]
+         * @param {string} [correlationId] of an action of a batch, under which
+         *   its outcome is published
          * @returns {Promise<void>}
          */
-        handleBridgeAction(actionCapData, canSpend = false) {
+        handleBridgeAction(actionCapData, canSpend = false, correlationId) {
           const { facets } = this;
           const { offers } = facets;
           const { publicMarshaller } = shared;
+          const { updateRecorderKit } = this.state;
+          const correlation =
+            correlationId === undefined ? {} : { correlationId };
 
+          let errorRecorded = false;
           /** @param {Error} err */
           const recordError = err => {
-            const { updateRecorderKit } = this.state;
+            errorRecorded = true;
             facets.helper.logWalletError('handleBridgeAction error:', err);
             void updateRecorderKit.recorder.write({
               updated: 'walletAction',
+              ...correlation,
               status: { error: err.message },
             });
           };
 
           // use E.when to retain distributed stack trace
-          return E.when(
+          const handled = E.when(
             E(publicMarshaller).fromCapData(actionCapData),
             /** @param {BridgeAction} action */
             action => {
@@ -1166,6 +1180,27 @@ export const prepareSmartWallet = (baggage, shared) => {
             // record errors in the unserialize and leave the rejection handled
             recordError,
           );
+          if (correlationId === undefined) {
+            return handled;
+          }
+          // publish the outcome of an action of a batch, including an async
+          // rejection, which the offer handler has also recorded
+          return E.when(
+            handled,
+            () => {
+              if (!errorRecorded) {
+                void updateRecorderKit.recorder.write({
+                  updated: 'walletAction',
+                  correlationId,
+                  status: { done: true },
+                });
+              }
+            },
+            err => {
+              recordError(err);
+              throw err;
+            },
+          );
         },
         getDepositFacet() {
           return this.facets.deposit;
@@ -82,6 +82,31 @@ export const shape = {
     blockTime: M.number(),
     owner: M.string(),
   }),
+
+  /**
+   * Defined by walletActionBatch struct in msg_server.go
+   *
+   * @see walletActionBatch in msg_server.go
+   */
+  WalletActionBatchMsg: M.splitRecord({
+    type: 'WALLET_ACTION_BATCH',
+    actions: M.arrayOf(
+      M.splitRecord({
+        id: M.string(),
+        correlationId: M.string(),
+        action: M.string(),
+        spend: M.boolean(),
+      }),
+    ),
+
+    blockHeight: M.number(),
+    blockTime: M.number(),
+    owner: M.string(),
+  }),
 };
-shape.WalletBridgeMsg = M.or(shape.WalletActionMsg, shape.WalletSpendActionMsg);
+shape.WalletBridgeMsg = M.or(
+  shape.WalletActionMsg,
+  shape.WalletSpendActionMsg,
+  shape.WalletActionBatchMsg,
+);
 harden(shape);
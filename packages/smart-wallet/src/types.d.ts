@@ -69,14 +69,44 @@ export type WalletSpendActionMsg = {
   blockTime: unknown; // int64
 };
 
+/**
+ * Defined by walletActionBatch struct in msg_server.go
+ *
+ * @see {agoric.swingset.MsgWalletActionBatch} and walletActionBatch in msg_server.go
+ */
+export type WalletActionBatchMsg = {
+  type: 'WALLET_ACTION_BATCH';
+  /** base64 of Uint8Array of bech32 data  */
+  owner: string;
+  actions: Array<{
+    /** unique within the batch */
+    id: string;
+    /**
+     * `${txHash}/${msgIdx}/${id}`, with which the wallet publishes the outcome
+     * of the action as a 'walletAction' update
+     */
+    correlationId: string;
+    /** JSON of BridgeActionCapData */
+    action: string;
+    /** whether the action has spend authority */
+    spend: boolean;
+  }>;
+  blockHeight: unknown; // int64
+  blockTime: unknown; // int64
+};
+
 /**
  * Messages transmitted over Cosmos chain, cryptographically verifying that the
  * message came from the 'owner'.
  *
  * The two wallet actions are distinguished by whether the user had to confirm
- * the sending of the message (as is the case for WALLET_SPEND_ACTION).
+ * the sending of the message (as is the case for WALLET_SPEND_ACTION).  A
+ * WALLET_ACTION_BATCH carries an ordered list of either kind.
  */
-export type WalletBridgeMsg = WalletActionMsg | WalletSpendActionMsg;
+export type WalletBridgeMsg =
+  | WalletActionMsg
+  | WalletSpendActionMsg
+  | WalletActionBatchMsg;
 
 /**
  * Used for clientSupport helpers
@@ -195,24 +195,61 @@ export const prepare = async (zcf, privateArgs, baggage) => {
       fromBridge: async obj => {
         console.log('walletFactory.fromBridge:', obj);
 
-        const canSpend = 'spendAction' in obj;
+        /**
+         * Revive an old wallet if necessary, but otherwise insist that it is
+         * already in the store.
+         *
+         * @param {string} address
+         */
+        const provideWallet = address =>
+          !walletsByAddress.has(address) && walletReviver
+            ? // this will call provideSmartWallet which will update `walletsByAddress` for next time
+              E(walletReviver).reviveWallet(address)
+            : walletsByAddress.get(address); // or throw
 
         // xxx capData body is also a JSON string so this is double-encoded
         // revisit after https://github.com/Agoric/agoric-sdk/issues/2589
-        const actionCapData = JSON.parse(
+        /** @param {string} actionJSON */
+        const parseAction = actionJSON => {
+          const actionCapData = JSON.parse(actionJSON);
+          mustMatch(harden(actionCapData), shape.StringCapData);
+          return actionCapData;
+        };
+
+        if ('actions' in obj) {
+          // Validate the whole batch before performing any of it.
+          const actions = obj.actions.map(
+            ({ action, spend, correlationId }) => ({
+              actionCapData: parseAction(action),
+              canSpend: spend,
+              correlationId,
+            }),
+          );
+          const wallet = await provideWallet(obj.owner);
+
+          // Perform the actions in order.  The wallet publishes the outcome of
+          // each under its correlation ID, so one failing does not prevent the
+          // rest.
+          for (const { actionCapData, canSpend, correlationId } of actions) {
+            console.log('walletFactory:', {
+              wallet,
+              actionCapData,
+              correlationId,
+            });
+            await E(wallet)
+              .handleBridgeAction(actionCapData, canSpend, correlationId)
+              .catch(err =>
+                console.error('walletFactory:', correlationId, 'failed:', err),
+              );
+          }
+          return;
+        }
+
+        const canSpend = 'spendAction' in obj;
+        const actionCapData = parseAction(
           canSpend ? obj.spendAction : obj.action,
         );
-        mustMatch(harden(actionCapData), shape.StringCapData);
-
-        // Revive an old wallet if necessary, but otherwise
-        // insist that it is already in the store.
-        const address = obj.owner;
-        const walletP =
-          !walletsByAddress.has(address) && walletReviver
-            ? // this will call provideSmartWallet which will update `walletsByAddress` for next time
-              E(walletReviver).reviveWallet(address)
-            : walletsByAddress.get(address); // or throw
-        const wallet = await walletP;
+        const wallet = await provideWallet(obj.owner);
 
         console.log('walletFactory:', { wallet, actionCapData });
         return E(wallet).handleBridgeAction(actionCapData, canSpend);
@@ -2,6 +2,7 @@ import { test as anyTest } from '@agoric/zoe/tools/prepare-test-env-ava.js';
 
 import { makeHandle } from '@agoric/zoe/src/makeHandle.js';
 import { E } from '@endo/far';
+import { eventLoopIteration } from '@agoric/internal/src/testing-utils.js';
 import { makeImportContext } from '../src/marshal-contexts.js';
 import { makeDefaultTestContext } from './contexts.js';
 import {
@@ -187,12 +188,67 @@ test('bad action value', async t => {
   // the signal of an error is available in chain storage
   const head = await headValue(updates);
   // For type narrowing
-  assert(head.updated === 'walletAction');
+  assert(head.updated === 'walletAction' && 'error' in head.status);
   // The rest of the error message is different in Node 18 vs 20. On chain it
   // will come from an XS version that is in common across all validators.
   t.regex(head.status.error, /Unexpected token/);
 });
 
+test('batch action outcomes by correlation ID', async t => {
+  const owner = 'agoric1batchOutcomes';
+  await t.context.simpleProvideWallet(owner);
+  const ctx = makeImportContext();
+  /** @param {import('../src/smartWallet.js').BridgeAction} action */
+  const toActionJSON = action =>
+    JSON.stringify(ctx.fromBoard.toCapData(harden(action)));
+
+  const batchMsg = {
+    type: ActionType.WALLET_ACTION_BATCH,
+    owner,
+    actions: [
+      {
+        id: 'a',
+        correlationId: 'tx1/0/a',
+        // fails for lack of spend authority
+        action: toActionJSON({ method: 'tryExitOffer', offerId: 'irrelevant' }),
+        spend: false,
+      },
+      {
+        id: 'b',
+        correlationId: 'tx1/0/b',
+        // fails asynchronously for lack of such an offer
+        action: toActionJSON({ method: 'tryExitOffer', offerId: 'unknown' }),
+        spend: true,
+      },
+    ],
+    blockTime: 0,
+    blockHeight: 0,
+  };
+  assert(t.context.sendToBridge);
+  // sending over the bridge succeeds although every action fails
+  await t.context.sendToBridge(batchMsg);
+  await eventLoopIteration();
+
+  // the outcome of each action is available in chain storage
+  const storage = await t.context.consume.chainStorage;
+  /** @param {number} index */
+  const getUpdate = index =>
+    storage.getBody(
+      `mockChainStorageRoot.wallet.${owner}`,
+      ctx.fromBoard,
+      index,
+    );
+  t.deepEqual(getUpdate(-2), {
+    updated: 'walletAction',
+    correlationId: 'tx1/0/a',
+    status: { error: 'tryExitOffer requires spend authority' },
+  });
+  t.like(getUpdate(-1), {
+    updated: 'walletAction',
+    correlationId: 'tx1/0/b',
+  });
+});
+
 test('notifiers', async t => {
   async function checkAddress(address) {
     const smartWallet = await t.context.simpleProvideWallet(address);